@@ -0,0 +1,142 @@
+package alphavantage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/portfoliotree/alphavantage/api"
+	"github.com/portfoliotree/alphavantage/query/fundamental"
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+)
+
+// AdjustedDailyRow is a back-adjusted daily bar. Prices are adjusted for the
+// splits and dividends after the bar so they are comparable with the most
+// recent bar, which is not changed.
+type AdjustedDailyRow struct {
+	TimeStamp time.Time
+	Open      float64
+	High      float64
+	Low       float64
+	Close     float64
+	// Volume is adjusted for splits only.
+	Volume float64
+	// TotalReturn is the value of one unit invested at the close of the
+	// oldest bar with dividends reinvested.
+	TotalReturn float64
+	// DividendAmount is the unadjusted dividend going ex on this bar.
+	DividendAmount float64
+	// SplitCoefficient is the split factor effective on this bar or 1.
+	SplitCoefficient float64
+}
+
+// CorporateAction is a split or dividend considered by AdjustDaily.
+type CorporateAction struct {
+	Date time.Time
+	// Split is the split factor; it is zero for dividends.
+	Split api.Ratio
+	// Dividend is the unadjusted amount; it is zero for splits.
+	Dividend float64
+	// PriceFactor multiplies the prices of the bars before Date. For a
+	// split VolumeFactor is its inverse; for a dividend it is 1.
+	PriceFactor  float64
+	VolumeFactor float64
+	// Applied is false when no bar precedes Date or no bar is on or after
+	// it, such as a dividend that has been declared but has not gone ex.
+	Applied bool
+}
+
+// AdjustedDaily is the result of AdjustDaily.
+type AdjustedDaily struct {
+	// Rows are ordered from the most recent bar to the oldest, like the
+	// AlphaVantage responses.
+	Rows []AdjustedDailyRow
+	// Actions are ordered by date from the most recent.
+	Actions []CorporateAction
+}
+
+// AdjustDaily back-adjusts TIME_SERIES_DAILY rows with SPLITS and DIVIDENDS
+// rows. A split divides the earlier prices by the split factor and
+// multiplies the earlier volumes by it. A dividend multiplies the earlier
+// prices by close/(close+dividend) of the ex-dividend bar, which is how
+// TIME_SERIES_DAILY_ADJUSTED computes adjusted_close.
+func AdjustDaily(daily []timeseries.DailyRow, splits []fundamental.SplitsRow, dividends []fundamental.DividendsRow) AdjustedDaily {
+	bars := slices.Clone(daily)
+	slices.SortFunc(bars, func(a, b timeseries.DailyRow) int { return b.TimeStamp.Compare(a.TimeStamp) })
+
+	var actions []CorporateAction
+	for _, s := range splits {
+		actions = append(actions, CorporateAction{Date: s.EffectiveDate, Split: s.SplitFactor, PriceFactor: 1, VolumeFactor: 1})
+	}
+	for _, d := range dividends {
+		actions = append(actions, CorporateAction{Date: d.ExDividendDate, Dividend: d.Amount, PriceFactor: 1, VolumeFactor: 1})
+	}
+	slices.SortStableFunc(actions, func(a, b CorporateAction) int { return b.Date.Compare(a.Date) })
+
+	result := AdjustedDaily{Rows: make([]AdjustedDailyRow, len(bars)), Actions: actions}
+	priceFactor, volumeFactor := 1.0, 1.0
+	next := 0
+	for i, bar := range bars {
+		row := AdjustedDailyRow{TimeStamp: bar.TimeStamp, SplitCoefficient: 1}
+		// actions dated after this bar, and not after the more recent bar
+		// handled in the previous iteration, take effect on that bar
+		for ; next < len(actions) && actions[next].Date.After(bar.TimeStamp); next++ {
+			a := &actions[next]
+			if i == 0 {
+				continue
+			}
+			applyCorporateAction(a, &result.Rows[i-1], bars[i-1])
+			priceFactor *= a.PriceFactor
+			volumeFactor *= a.VolumeFactor
+		}
+		row.Open = bar.Open * priceFactor
+		row.High = bar.High * priceFactor
+		row.Low = bar.Low * priceFactor
+		row.Close = bar.Close * priceFactor
+		row.Volume = float64(bar.Volume) * volumeFactor
+		result.Rows[i] = row
+	}
+	for i := range result.Rows {
+		row := &result.Rows[i]
+		row.TotalReturn = row.Close / result.Rows[len(result.Rows)-1].Close
+	}
+	return result
+}
+
+func applyCorporateAction(a *CorporateAction, exBar *AdjustedDailyRow, raw timeseries.DailyRow) {
+	if a.Split > 0 {
+		a.PriceFactor = 1 / float64(a.Split)
+		a.VolumeFactor = float64(a.Split)
+		exBar.SplitCoefficient *= float64(a.Split)
+		a.Applied = true
+		return
+	}
+	if a.Dividend > 0 && raw.Close > 0 {
+		a.PriceFactor = raw.Close / (raw.Close + a.Dividend)
+		exBar.DividendAmount += a.Dividend
+		a.Applied = true
+	}
+}
+
+// AdjustedDaily fetches TIME_SERIES_DAILY, SPLITS and DIVIDENDS for the
+// query's symbol and back-adjusts the prices locally with AdjustDaily. It is
+// an alternative to TIME_SERIES_DAILY_ADJUSTED, which requires a premium
+// plan.
+func (f *TimeSeriesFunctions) AdjustedDaily(ctx context.Context, query timeseries.DailyQuery) (AdjustedDaily, error) {
+	symbol := url.Values(query).Get("symbol")
+	daily, err := f.Daily(ctx, query)
+	if err != nil {
+		return AdjustedDaily{}, err
+	}
+	splits, err := (*FundamentalFunctions)(f).Splits(ctx, fundamental.QuerySplits(f.APIKey, symbol).DataTypeCSV())
+	if err != nil {
+		return AdjustedDaily{}, fmt.Errorf("failed to get splits for %s: %w", symbol, err)
+	}
+	dividends, err := (*FundamentalFunctions)(f).Dividends(ctx, fundamental.QueryDividends(f.APIKey, symbol).DataTypeCSV())
+	if err != nil {
+		return AdjustedDaily{}, fmt.Errorf("failed to get dividends for %s: %w", symbol, err)
+	}
+	return AdjustDaily(daily, splits, dividends), nil
+}
@@ -0,0 +1,272 @@
+// Package analytics computes the statistics of the AlphaVantage
+// ANALYTICS_FIXED_WINDOW and ANALYTICS_SLIDING_WINDOW functions locally, so
+// they can be evaluated over stored prices for any number of symbol and date
+// range combinations without requests. The results have the types the
+// client decodes the responses into.
+//
+// Like AlphaVantage, the statistics are computed from the simple returns of
+// consecutive prices in the panel, starting with the return of the second
+// date. Standard deviation, variance and covariance are population
+// statistics and annualized calculations scale by the number of periods in a
+// year of the interval (252 for DAILY). AlphaVantage uses adjusted closing
+// prices, so build the panel from TIME_SERIES_DAILY_ADJUSTED rows to
+// reproduce its values:
+//
+//	panel := alphavantage.NewPanel(alphavantage.Intersection, symbols, series,
+//		func(row timeseries.DailyAdjustedRow) time.Time { return row.TimeStamp },
+//		func(row timeseries.DailyAdjustedRow) float64 { return row.AdjustedClose },
+//	)
+//	result, err := analytics.FixedWindow(panel.Between(from, to), "DAILY",
+//		intelligence.Mean, intelligence.StdDev.Annualized(), intelligence.Correlation)
+//
+// Returns involving a NaN price are skipped; pairwise statistics use the
+// dates where both symbols have a return.
+package analytics
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/portfoliotree/alphavantage"
+	"github.com/portfoliotree/alphavantage/query/intelligence"
+)
+
+// FixedWindow computes calculations over all the dates of prices. The
+// interval (DAILY, WEEKLY or MONTHLY) is only used to annualize.
+func FixedWindow(prices alphavantage.Panel, interval string, calculations ...intelligence.Calculation) (intelligence.FixedWindowAnalytics, error) {
+	result := intelligence.FixedWindowAnalytics{
+		MetaData:     metaData(prices, interval),
+		Calculations: calculations,
+	}
+	returns := make([][]float64, len(prices.Columns))
+	for i, column := range prices.Columns {
+		returns[i] = Returns(column)
+	}
+	perSymbol := func(dst *map[string]float64, stat func(prices, returns []float64) float64) {
+		*dst = make(map[string]float64, len(prices.Symbols))
+		for i, symbol := range prices.Symbols {
+			(*dst)[symbol] = stat(prices.Columns[i], returns[i])
+		}
+	}
+	for _, c := range calculations {
+		scale, err := annualization(c, interval)
+		if err != nil {
+			return result, err
+		}
+		switch c.Name() {
+		case intelligence.Mean:
+			perSymbol(&result.Mean, func(_, r []float64) float64 { return mean(r) })
+		case intelligence.StdDev:
+			perSymbol(&result.StdDev, func(_, r []float64) float64 { return math.Sqrt(variance(r) * scale) })
+		case intelligence.Variance:
+			perSymbol(&result.Variance, func(_, r []float64) float64 { return variance(r) * scale })
+		case intelligence.CumulativeReturn:
+			perSymbol(&result.CumulativeReturn, func(p, _ []float64) float64 { return cumulativeReturn(p) })
+		case intelligence.Autocorrelation:
+			lag, err := autocorrelationLag(c)
+			if err != nil {
+				return result, err
+			}
+			perSymbol(&result.Autocorrelation, func(_, r []float64) float64 { return autocorrelation(r, lag) })
+		case intelligence.MaxDrawdown:
+			result.MaxDrawdown = make(map[string]intelligence.Drawdown, len(prices.Symbols))
+			for i, symbol := range prices.Symbols {
+				result.MaxDrawdown[symbol] = maxDrawdown(prices, i)
+			}
+		case intelligence.Correlation:
+			if method, ok := c.Param("method"); ok && !strings.EqualFold(method, "PEARSON") {
+				return result, fmt.Errorf("%s is not supported locally", c)
+			}
+			result.Correlation = matrix(prices.Symbols, returns, correlation)
+		case intelligence.Covariance:
+			result.Covariance = matrix(prices.Symbols, returns, func(a, b []float64) float64 { return covariance(a, b) * scale })
+		default:
+			return result, fmt.Errorf("%s is not supported locally", c)
+		}
+	}
+	return result, nil
+}
+
+func metaData(prices alphavantage.Panel, interval string) intelligence.AnalyticsMetaData {
+	m := intelligence.AnalyticsMetaData{
+		Symbols:  prices.Symbols,
+		Interval: strings.ToUpper(interval),
+	}
+	if len(prices.Dates) > 0 {
+		m.MinDate = prices.Dates[0]
+		m.MaxDate = prices.Dates[len(prices.Dates)-1]
+	}
+	return m
+}
+
+// Returns returns the simple returns of prices. The result has one value
+// less than prices; it is NaN where either price is NaN.
+func Returns(prices []float64) []float64 {
+	if len(prices) < 2 {
+		return nil
+	}
+	returns := make([]float64, len(prices)-1)
+	for i := range returns {
+		returns[i] = prices[i+1]/prices[i] - 1
+	}
+	return returns
+}
+
+// periodsPerYear is the annualization factor of the analytics intervals.
+func periodsPerYear(interval string) (float64, error) {
+	switch strings.ToUpper(interval) {
+	case "DAILY":
+		return 252, nil
+	case "WEEKLY":
+		return 52, nil
+	case "MONTHLY":
+		return 12, nil
+	}
+	return 0, fmt.Errorf("can not annualize interval %q", interval)
+}
+
+// annualization returns the factor variances are scaled by for c.
+func annualization(c intelligence.Calculation, interval string) (float64, error) {
+	if !c.IsAnnualized() {
+		return 1, nil
+	}
+	return periodsPerYear(interval)
+}
+
+func autocorrelationLag(c intelligence.Calculation) (int, error) {
+	value, ok := c.Param("lag")
+	if !ok {
+		return 1, nil
+	}
+	lag, err := strconv.Atoi(value)
+	if err != nil || lag < 1 {
+		return 0, fmt.Errorf("invalid lag in %s", c)
+	}
+	return lag, nil
+}
+
+func mean(values []float64) float64 {
+	sum, n := 0.0, 0
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			sum += v
+			n++
+		}
+	}
+	if n == 0 {
+		return math.NaN()
+	}
+	return sum / float64(n)
+}
+
+func variance(values []float64) float64 {
+	return covariance(values, values)
+}
+
+// covariance is the population covariance over the indexes where a and b
+// are both set.
+func covariance(a, b []float64) float64 {
+	var sumA, sumB, sumAB float64
+	n := 0
+	for i := range min(len(a), len(b)) {
+		if math.IsNaN(a[i]) || math.IsNaN(b[i]) {
+			continue
+		}
+		sumA += a[i]
+		sumB += b[i]
+		n++
+	}
+	if n == 0 {
+		return math.NaN()
+	}
+	meanA, meanB := sumA/float64(n), sumB/float64(n)
+	for i := range min(len(a), len(b)) {
+		if math.IsNaN(a[i]) || math.IsNaN(b[i]) {
+			continue
+		}
+		sumAB += (a[i] - meanA) * (b[i] - meanB)
+	}
+	return sumAB / float64(n)
+}
+
+// correlation is the Pearson correlation over the indexes where a and b are
+// both set.
+func correlation(a, b []float64) float64 {
+	a, b = joint(a, b)
+	return covariance(a, b) / math.Sqrt(variance(a)*variance(b))
+}
+
+// joint returns the values of a and b at the indexes where both are set.
+func joint(a, b []float64) ([]float64, []float64) {
+	n := min(len(a), len(b))
+	ja, jb := make([]float64, 0, n), make([]float64, 0, n)
+	for i := range n {
+		if !math.IsNaN(a[i]) && !math.IsNaN(b[i]) {
+			ja = append(ja, a[i])
+			jb = append(jb, b[i])
+		}
+	}
+	return ja, jb
+}
+
+// autocorrelation is the correlation of returns with the returns lag periods
+// earlier.
+func autocorrelation(returns []float64, lag int) float64 {
+	if lag >= len(returns) {
+		return math.NaN()
+	}
+	return correlation(returns[lag:], returns[:len(returns)-lag])
+}
+
+func cumulativeReturn(prices []float64) float64 {
+	first, last := math.NaN(), math.NaN()
+	for _, p := range prices {
+		if math.IsNaN(p) {
+			continue
+		}
+		if math.IsNaN(first) {
+			first = p
+		}
+		last = p
+	}
+	return last/first - 1
+}
+
+func maxDrawdown(prices alphavantage.Panel, column int) intelligence.Drawdown {
+	var result intelligence.Drawdown
+	peak := -1
+	for i, p := range prices.Columns[column] {
+		if math.IsNaN(p) {
+			continue
+		}
+		if peak < 0 || p > prices.Columns[column][peak] {
+			peak = i
+			continue
+		}
+		if drawdown := p/prices.Columns[column][peak] - 1; drawdown < result.MaxDrawdown {
+			result = intelligence.Drawdown{
+				MaxDrawdown: drawdown,
+				Start:       prices.Dates[peak],
+				End:         prices.Dates[i],
+			}
+		}
+	}
+	return result
+}
+
+// matrix returns the lower triangle of the symmetric matrix of stat.
+func matrix(symbols []string, returns [][]float64, stat func(a, b []float64) float64) *intelligence.Matrix {
+	m := &intelligence.Matrix{
+		Index:  symbols,
+		Values: make([][]float64, len(symbols)),
+	}
+	for i := range symbols {
+		m.Values[i] = make([]float64, i+1)
+		for j := range i + 1 {
+			m.Values[i][j] = stat(returns[i], returns[j])
+		}
+	}
+	return m
+}
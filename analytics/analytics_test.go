@@ -0,0 +1,130 @@
+package analytics_test
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/portfoliotree/alphavantage"
+	"github.com/portfoliotree/alphavantage/analytics"
+	"github.com/portfoliotree/alphavantage/api"
+	"github.com/portfoliotree/alphavantage/query/intelligence"
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+)
+
+func date(t *testing.T, value string) time.Time {
+	t.Helper()
+	d, err := time.Parse(time.DateOnly, value)
+	require.NoError(t, err)
+	return d
+}
+
+func adjustedIBM(t *testing.T) alphavantage.Panel {
+	t.Helper()
+	f, err := os.Open(filepath.FromSlash("../specification/testdata/examples/time_series/TIME_SERIES_DAILY_ADJUSTED_572d0539.csv"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+	var rows []timeseries.DailyAdjustedRow
+	require.NoError(t, api.ParseCSV(f, &rows, time.UTC))
+	return alphavantage.NewPanel(alphavantage.Intersection, []string{"IBM"}, [][]timeseries.DailyAdjustedRow{rows},
+		func(row timeseries.DailyAdjustedRow) time.Time { return row.TimeStamp },
+		func(row timeseries.DailyAdjustedRow) float64 { return row.AdjustedClose },
+	)
+}
+
+// slidingSample reads the ANALYTICS_SLIDING_WINDOW example, which used 20
+// day windows from 2026-03-16 to 2026-05-15.
+func slidingSample(t *testing.T) intelligence.SlidingWindowAnalytics {
+	t.Helper()
+	buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/intelligence/ANALYTICS_SLIDING_WINDOW_5a09754f.json"))
+	require.NoError(t, err)
+	var result intelligence.SlidingWindowAnalytics
+	require.NoError(t, json.Unmarshal(buf, &result))
+	return result
+}
+
+func TestFixedWindow_matchesAlphaVantage(t *testing.T) {
+	panel := adjustedIBM(t)
+	sample := slidingSample(t)
+	require.NotEmpty(t, sample.Mean["IBM"])
+
+	for i, expected := range sample.Mean["IBM"] {
+		// a window of 20 returns spans 21 prices
+		window := panel.Between(expected.Start, expected.End)
+		require.Len(t, window.Dates, 21)
+
+		result, err := analytics.FixedWindow(window, "DAILY", intelligence.Mean, intelligence.StdDev.Annualized())
+		require.NoError(t, err)
+		assert.InDelta(t, expected.Value, result.Mean["IBM"], 1e-12, expected.End)
+		assert.InDelta(t, sample.StdDev["IBM"][i].Value, result.StdDev["IBM"], 1e-12, expected.End)
+	}
+}
+
+func TestFixedWindow(t *testing.T) {
+	nan := math.NaN()
+	panel := alphavantage.Panel{
+		Dates:   []time.Time{date(t, "2024-01-02"), date(t, "2024-01-03"), date(t, "2024-01-04"), date(t, "2024-01-05"), date(t, "2024-01-08")},
+		Symbols: []string{"A", "B"},
+		Columns: [][]float64{
+			{100, 110, 99, 120, 108},
+			{50, 55, nan, 60, 66},
+		},
+	}
+
+	result, err := analytics.FixedWindow(panel, "daily",
+		intelligence.Mean,
+		intelligence.Variance,
+		intelligence.Variance.Annualized(),
+		intelligence.CumulativeReturn,
+		intelligence.MaxDrawdown,
+		intelligence.Correlation,
+		intelligence.Covariance,
+		intelligence.Autocorrelation.Lag(1),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"A", "B"}, result.MetaData.Symbols)
+	assert.Equal(t, date(t, "2024-01-02"), result.MetaData.MinDate)
+	assert.Equal(t, date(t, "2024-01-08"), result.MetaData.MaxDate)
+	assert.Equal(t, "DAILY", result.MetaData.Interval)
+
+	returnsA := []float64{0.1, -0.1, 120.0/99 - 1, -0.1}
+	meanA := (returnsA[0] + returnsA[1] + returnsA[2] + returnsA[3]) / 4
+	assert.InDelta(t, meanA, result.Mean["A"], 1e-12)
+	assert.InDelta(t, 0.1, result.Mean["B"], 1e-12, "returns next to a gap are skipped")
+	assert.InDelta(t, 0, result.Variance["B"], 1e-12)
+	assert.InDelta(t, 252*result.Covariance.Values[0][0], result.Variance["A"], 1e-12, "the last variance is annualized")
+
+	assert.InDelta(t, 0.08, result.CumulativeReturn["A"], 1e-12)
+	assert.InDelta(t, 0.32, result.CumulativeReturn["B"], 1e-12)
+
+	drawdown := result.MaxDrawdown["A"]
+	assert.InDelta(t, -0.1, drawdown.MaxDrawdown, 1e-12)
+	assert.Equal(t, date(t, "2024-01-03"), drawdown.Start, "the first of equal drawdowns is kept")
+	assert.Equal(t, date(t, "2024-01-04"), drawdown.End)
+	assert.Zero(t, result.MaxDrawdown["B"])
+
+	require.NotNil(t, result.Correlation)
+	assert.Equal(t, []string{"A", "B"}, result.Correlation.Index)
+	aa, ok := result.Correlation.At("A", "A")
+	require.True(t, ok)
+	assert.InDelta(t, 1, aa, 1e-12)
+	ab, ok := result.Correlation.At("B", "A")
+	require.True(t, ok)
+	assert.True(t, math.IsNaN(ab), "B has no variance on the dates both symbols have returns")
+
+	assert.InDelta(t, -0.935029754396332, result.Autocorrelation["A"], 1e-12)
+
+	_, err = analytics.FixedWindow(panel, "DAILY", intelligence.Calculation("HISTOGRAM(bins=20)"))
+	assert.Error(t, err)
+	_, err = analytics.FixedWindow(panel, "5min", intelligence.StdDev.Annualized())
+	assert.Error(t, err)
+	_, err = analytics.FixedWindow(panel, "DAILY", intelligence.Correlation+"(method=KENDALL)")
+	assert.Error(t, err)
+}
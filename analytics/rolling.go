@@ -0,0 +1,117 @@
+package analytics
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/portfoliotree/alphavantage"
+	"github.com/portfoliotree/alphavantage/query/intelligence"
+)
+
+// SlidingWindow computes calculations over each window of windowSize
+// returns, like ANALYTICS_SLIDING_WINDOW. The first window ends on the date
+// after windowSize returns; its Start is the date of the price its first
+// return is computed from. MEAN, STDDEV, VARIANCE, CORRELATION and
+// COVARIANCE are supported. Pairwise statistics are keyed "<A>-<B>" with A
+// before B in the panel.
+func SlidingWindow(prices alphavantage.Panel, interval string, windowSize int, calculations ...intelligence.Calculation) (intelligence.SlidingWindowAnalytics, error) {
+	result := intelligence.SlidingWindowAnalytics{
+		MetaData:     metaData(prices, interval),
+		Calculations: calculations,
+	}
+	result.MetaData.WindowSize = windowSize
+	if windowSize < 2 {
+		return result, fmt.Errorf("window size %d is less than 2", windowSize)
+	}
+	returns := make([][]float64, len(prices.Columns))
+	for i, column := range prices.Columns {
+		returns[i] = Returns(column)
+	}
+	perSymbol := func(stat func(r []float64) float64) map[string][]intelligence.RollingValue {
+		m := make(map[string][]intelligence.RollingValue, len(prices.Symbols))
+		for i, symbol := range prices.Symbols {
+			m[symbol] = rolling(prices, windowSize, func(start, end int) float64 { return stat(returns[i][start:end]) })
+		}
+		return m
+	}
+	pairs := func(stat func(a, b []float64) float64) map[string][]intelligence.RollingValue {
+		m := make(map[string][]intelligence.RollingValue)
+		for i := range prices.Symbols {
+			for j := i + 1; j < len(prices.Symbols); j++ {
+				m[prices.Symbols[i]+"-"+prices.Symbols[j]] = rolling(prices, windowSize, func(start, end int) float64 {
+					return stat(returns[i][start:end], returns[j][start:end])
+				})
+			}
+		}
+		return m
+	}
+	for _, c := range calculations {
+		scale, err := annualization(c, interval)
+		if err != nil {
+			return result, err
+		}
+		switch c.Name() {
+		case intelligence.Mean:
+			result.Mean = perSymbol(mean)
+		case intelligence.StdDev:
+			result.StdDev = perSymbol(func(r []float64) float64 { return math.Sqrt(variance(r) * scale) })
+		case intelligence.Variance:
+			result.Variance = perSymbol(func(r []float64) float64 { return variance(r) * scale })
+		case intelligence.Correlation:
+			result.Correlation = pairs(correlation)
+		case intelligence.Covariance:
+			result.Covariance = pairs(func(a, b []float64) float64 { return covariance(a, b) * scale })
+		default:
+			return result, fmt.Errorf("%s is not supported by the sliding window", c)
+		}
+	}
+	return result, nil
+}
+
+// RollingBeta computes the beta of each symbol against benchmark over each
+// window of windowSize returns: the covariance of the symbol and benchmark
+// returns divided by the variance of the benchmark returns. The windows
+// match SlidingWindow.
+func RollingBeta(prices alphavantage.Panel, benchmark string, windowSize int) (map[string][]intelligence.RollingValue, error) {
+	b := slices.Index(prices.Symbols, benchmark)
+	if b < 0 {
+		return nil, fmt.Errorf("benchmark %s is not in the panel", benchmark)
+	}
+	if windowSize < 2 {
+		return nil, fmt.Errorf("window size %d is less than 2", windowSize)
+	}
+	benchmarkReturns := Returns(prices.Columns[b])
+	result := make(map[string][]intelligence.RollingValue, len(prices.Symbols)-1)
+	for i, symbol := range prices.Symbols {
+		if i == b {
+			continue
+		}
+		returns := Returns(prices.Columns[i])
+		result[symbol] = rolling(prices, windowSize, func(start, end int) float64 {
+			r, m := joint(returns[start:end], benchmarkReturns[start:end])
+			return covariance(r, m) / variance(m)
+		})
+	}
+	return result, nil
+}
+
+// rolling evaluates stat for each window of windowSize returns given as the
+// range of return indexes [start, end). Return i is computed from the prices
+// on dates i and i+1.
+func rolling(prices alphavantage.Panel, windowSize int, stat func(start, end int) float64) []intelligence.RollingValue {
+	n := len(prices.Dates) - windowSize
+	if n <= 0 {
+		return nil
+	}
+	values := make([]intelligence.RollingValue, n)
+	for start := range n {
+		end := start + windowSize
+		values[start] = intelligence.RollingValue{
+			Start: prices.Dates[start],
+			End:   prices.Dates[end],
+			Value: stat(start, end),
+		}
+	}
+	return values
+}
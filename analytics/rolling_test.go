@@ -0,0 +1,85 @@
+package analytics_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/portfoliotree/alphavantage"
+	"github.com/portfoliotree/alphavantage/analytics"
+	"github.com/portfoliotree/alphavantage/query/intelligence"
+)
+
+func TestSlidingWindow_matchesAlphaVantage(t *testing.T) {
+	sample := slidingSample(t)
+	panel := adjustedIBM(t).Between(sample.MetaData.MinDate, sample.MetaData.MaxDate)
+
+	result, err := analytics.SlidingWindow(panel, sample.MetaData.Interval, sample.MetaData.WindowSize, sample.Calculations...)
+	require.NoError(t, err)
+	assert.Equal(t, 20, result.MetaData.WindowSize)
+
+	for _, stat := range []struct {
+		name             string
+		expected, actual []intelligence.RollingValue
+	}{
+		{name: "mean", expected: sample.Mean["IBM"], actual: result.Mean["IBM"]},
+		{name: "stddev", expected: sample.StdDev["IBM"], actual: result.StdDev["IBM"]},
+	} {
+		require.Len(t, stat.actual, len(stat.expected), stat.name)
+		for i, expected := range stat.expected {
+			assert.Equal(t, expected.Start, stat.actual[i].Start, stat.name)
+			assert.Equal(t, expected.End, stat.actual[i].End, stat.name)
+			assert.InDelta(t, expected.Value, stat.actual[i].Value, 1e-12, stat.name)
+		}
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	// B moves twice as much as A
+	returnsA := []float64{0.01, -0.02, 0.015, 0.005, -0.01, 0.02}
+	a, b := []float64{100}, []float64{100}
+	for _, r := range returnsA {
+		a = append(a, a[len(a)-1]*(1+r))
+		b = append(b, b[len(b)-1]*(1+2*r))
+	}
+	dates := make([]time.Time, len(a))
+	for i := range dates {
+		dates[i] = time.Date(2024, 1, 1+i, 0, 0, 0, 0, time.UTC)
+	}
+	panel := alphavantage.Panel{Dates: dates, Symbols: []string{"A", "B"}, Columns: [][]float64{a, b}}
+
+	result, err := analytics.SlidingWindow(panel, "DAILY", 4, intelligence.Mean, intelligence.Variance, intelligence.Correlation)
+	require.NoError(t, err)
+	require.Len(t, result.Mean["A"], 3)
+	assert.Equal(t, dates[0], result.Mean["A"][0].Start)
+	assert.Equal(t, dates[4], result.Mean["A"][0].End)
+	assert.InDelta(t, (0.01-0.02+0.015+0.005)/4, result.Mean["A"][0].Value, 1e-12)
+	assert.InDelta(t, 4*result.Variance["A"][2].Value, result.Variance["B"][2].Value, 1e-12)
+	require.Len(t, result.Correlation["A-B"], 3)
+	for _, v := range result.Correlation["A-B"] {
+		assert.InDelta(t, 1, v.Value, 1e-9)
+	}
+
+	beta, err := analytics.RollingBeta(panel, "A", 4)
+	require.NoError(t, err)
+	assert.NotContains(t, beta, "A")
+	require.Len(t, beta["B"], 3)
+	for _, v := range beta["B"] {
+		assert.InDelta(t, 2, v.Value, 1e-9)
+	}
+
+	panel.Columns[1][3] = math.NaN()
+	beta, err = analytics.RollingBeta(panel, "A", 4)
+	require.NoError(t, err)
+	assert.InDelta(t, 2, beta["B"][0].Value, 1e-9, "returns next to a gap are skipped")
+
+	_, err = analytics.RollingBeta(panel, "SPY", 4)
+	assert.Error(t, err)
+	_, err = analytics.SlidingWindow(panel, "DAILY", 1, intelligence.Mean)
+	assert.Error(t, err)
+	_, err = analytics.SlidingWindow(panel, "DAILY", 4, intelligence.MaxDrawdown)
+	assert.Error(t, err)
+}
@@ -0,0 +1,40 @@
+package api_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/portfoliotree/alphavantage/api"
+	"github.com/portfoliotree/alphavantage/query/fundamental"
+	"github.com/portfoliotree/alphavantage/query/options"
+	"github.com/portfoliotree/alphavantage/query/technical"
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+)
+
+func BenchmarkParseCSV(b *testing.B) {
+	b.Run("LISTING_STATUS", benchmarkParseCSV[fundamental.ListingStatusRow]("fundamental/LISTING_STATUS_42ba3c24.csv"))
+	b.Run("MACD", benchmarkParseCSV[technical.MovingAverageConvergenceDivergenceRow]("technical_macd_stoch/MACD_19e73cc7.csv"))
+	b.Run("HISTORICAL_OPTIONS", benchmarkParseCSV[options.HistoricalRow]("options/HISTORICAL_OPTIONS_6c0b1a36.csv"))
+	b.Run("TIME_SERIES_DAILY_ADJUSTED", benchmarkParseCSV[timeseries.DailyAdjustedRow]("time_series/TIME_SERIES_DAILY_ADJUSTED_572d0539.csv"))
+	b.Run("TIME_SERIES_INTRADAY", benchmarkParseCSV[timeseries.IntradayRow]("time_series/TIME_SERIES_INTRADAY_3dbdcdc7.csv"))
+}
+
+func benchmarkParseCSV[T any](example string) func(b *testing.B) {
+	return func(b *testing.B) {
+		buf, err := os.ReadFile(filepath.Join("..", "specification", "testdata", "examples", filepath.FromSlash(example)))
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.SetBytes(int64(len(buf)))
+		b.ReportAllocs()
+		for b.Loop() {
+			for _, err := range api.Rows[T](bytes.NewReader(buf), nil) {
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	}
+}
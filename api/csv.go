@@ -3,6 +3,7 @@ package api
 import (
 	"bufio"
 	"bytes"
+	"cmp"
 	"encoding/csv"
 	"encoding/json"
 	"errors"
@@ -10,7 +11,7 @@ import (
 	"io"
 	"iter"
 	"reflect"
-	"strconv"
+	"slices"
 	"strings"
 	"time"
 )
@@ -18,6 +19,10 @@ import (
 // DefaultDateFormat is the RFC 3339 date format used for parsing dates.
 const DefaultDateFormat = "2006-01-02"
 
+// ErrPremiumEndpoint is wrapped by the error returned when AlphaVantage
+// responds with a notice that the function requires a premium plan.
+var ErrPremiumEndpoint = errors.New("alphavantage premium endpoint")
+
 func checkError(rc io.ReadCloser) (io.ReadCloser, error) {
 	var buf [1]byte
 	n, err := rc.Read(buf[:])
@@ -27,30 +32,14 @@ func checkError(rc io.ReadCloser) (io.ReadCloser, error) {
 
 	mr := io.MultiReader(bytes.NewReader(buf[:]), rc)
 	if n > 0 && buf[0] == '{' {
-		var message struct {
-			Note         string `json:"Note,omitempty"`
-			Information  string `json:"Information,omitempty"`
-			ErrorMessage string `json:"Error Message,omitempty"`
-			Detail       string `json:"detail,omitempty"`
-		}
+		var message notice
 		err = json.NewDecoder(mr).Decode(&message)
 		if err != nil {
 			return nil, fmt.Errorf("could not read response for: %w", err)
 		}
-		if strings.Contains(message.Note, " higher API call frequency") {
-			return nil, fmt.Errorf("reached alphavantage rate limit")
-		}
-
-		if message.ErrorMessage != "" {
-			return nil, fmt.Errorf("alphavantage request did not return csv; got notice: %w", errors.New(message.ErrorMessage))
-		}
-		if message.Detail != "" {
-			return nil, fmt.Errorf("alphavantage request did not return csv; got notice: %w", errors.New(message.Detail))
+		if err := message.err("csv"); err != nil {
+			return nil, err
 		}
-		if message.Note != "" || message.Information != "" {
-			return nil, fmt.Errorf("alphavantage request did not return csv; got notice: %w", errors.New(strings.Join([]string{message.Note, message.Information}, " ")))
-		}
-
 		return nil, fmt.Errorf("alphavantage request did not return csv")
 	}
 
@@ -60,6 +49,39 @@ func checkError(rc io.ReadCloser) (io.ReadCloser, error) {
 	}, nil
 }
 
+// notice is the JSON body AlphaVantage sends instead of the requested data.
+type notice struct {
+	Note         string `json:"Note,omitempty"`
+	Information  string `json:"Information,omitempty"`
+	ErrorMessage string `json:"Error Message,omitempty"`
+	Detail       string `json:"detail,omitempty"`
+	Message      string `json:"message,omitempty"`
+}
+
+// err returns the notice as an error or nil when it is empty. expected names
+// the data that was requested.
+func (message notice) err(expected string) error {
+	if strings.Contains(message.Note, " higher API call frequency") {
+		return fmt.Errorf("reached alphavantage rate limit")
+	}
+	for _, notice := range []string{message.Message, message.Information} {
+		if strings.Contains(notice, "premium endpoint") {
+			return fmt.Errorf("%w: %s", ErrPremiumEndpoint, notice)
+		}
+	}
+
+	if message.ErrorMessage != "" {
+		return fmt.Errorf("alphavantage request did not return %s; got notice: %w", expected, errors.New(message.ErrorMessage))
+	}
+	if message.Detail != "" {
+		return fmt.Errorf("alphavantage request did not return %s; got notice: %w", expected, errors.New(message.Detail))
+	}
+	if message.Note != "" || message.Information != "" || message.Message != "" {
+		return fmt.Errorf("alphavantage request did not return %s; got notice: %w", expected, errors.New(strings.TrimSpace(strings.Join([]string{message.Note, message.Information, message.Message}, " "))))
+	}
+	return nil
+}
+
 var typeType = reflect.TypeOf(time.Time{})
 
 type multiReadCloser struct {
@@ -75,13 +97,22 @@ func (mrc multiReadCloser) Close() error {
 //
 // Supported field types:
 //   - string: Direct mapping from CSV column value
-//   - int: Parsed using strconv.ParseInt with base 10
-//   - float64: Parsed using strconv.ParseFloat
+//   - bool: Parsed using strconv.ParseBool
+//   - int, int8 … int64: Parsed using strconv.ParseInt with base 10
+//   - uint, uint8 … uint64: Parsed using strconv.ParseUint with base 10
+//   - float32, float64: Parsed using strconv.ParseFloat
+//   - Percent: Parsed using ParsePercent (a trailing "%" is allowed)
+//   - Ratio: Parsed using ParseRatio ("2.0000", "2:1", …)
 //   - time.Time: Parsed using time.ParseInLocation (see time-layout tag)
+//   - time.Duration: Parsed using time.ParseDuration
+//   - types whose pointer implements CSVUnmarshaler or encoding.TextUnmarshaler
+//   - pointers to any of the above: nil when the value is missing
 //
 // Struct field tags:
 //   - `column-name:"header"`: Maps field to CSV column header (required)
-//   - `time-layout:"layout"`: Custom time format for time.Time fields (optional, defaults to "2006-01-02")
+//   - `time-layout:"layout"`: Custom time format for time.Time fields (optional, defaults to "2006-01-02").
+//     Alternative layouts may be separated by "|", the first layout that parses the value is used.
+//   - `null:"values"`: "|" separated values that mark a missing value (optional, see ParseCSVWith)
 //
 // Example struct:
 //
@@ -93,21 +124,89 @@ func (mrc multiReadCloser) Close() error {
 //	}
 //
 // Unmapped columns are ignored. Fields without matching columns keep their zero value.
-// Time fields with "null" values remain as zero time.Time.
+// Values in DefaultNullValues, such as "." or "None", are missing: float
+// fields are set to NaN, pointer fields stay nil and other fields keep their
+// zero value. Use ParseCSVWith or a `null` tag to change the missing values.
+//
+// If the body is a JSON notice instead of CSV (for example a rate limit or
+// premium endpoint message) the notice is returned as an error.
 func ParseCSV[T any](r io.Reader, data *[]T, location *time.Location) error {
+	return ParseCSVWith(r, data, CSVOptions{Location: location})
+}
+
+// CSVOptions configure ParseCSVWith and RowsWith.
+type CSVOptions struct {
+	// Location is used for times without a zone. It defaults to UTC.
+	Location *time.Location
+	// NullValues are the values that mark a missing value in fields without
+	// a null tag. They default to DefaultNullValues.
+	NullValues []string
+	// Mode selects how mismatches between the data and the row struct are
+	// handled.
+	Mode Mode
+	// Report, when not nil, collects the errors skipped in ModeLenient.
+	Report *Report
+	// OnSchemaDrift, when not nil, is called before the first row when the
+	// header has columns without a field (added) or lacks the column of a
+	// field (removed).
+	OnSchemaDrift func(added, removed []string)
+}
+
+// DefaultNullValues are the values AlphaVantage uses for missing values:
+// economic and commodity series use ".", fundamentals use "None" and some
+// columns are "-", "null" or empty.
+var DefaultNullValues = []string{"", ".", "-", "None", "null"}
+
+// ParseCSVWith is ParseCSV with options.
+//
+// A value is missing when it is one of the options NullValues or, for a
+// field with a `null:"N/A|-"` tag, one of the "|" separated tag values
+// (`null:""` makes only the empty value missing). A missing value leaves a
+// pointer field nil, sets float fields to NaN and leaves other fields at
+// their zero value; string fields always get the value as is.
+func ParseCSVWith[T any](r io.Reader, data *[]T, options CSVOptions) error {
 	if data == nil {
 		panic(fmt.Errorf("data must not be nil"))
 	}
 	var err error
-	for row := range parseCSVRows[T](ensureReadCloser(r), location, func(e error) bool {
-		err = e
-		return false
-	}) {
+	for row := range parseCSVRows[T](ensureReadCloser(r), options, errorHandler(options, &err)) {
 		*data = append(*data, row)
 	}
 	return err
 }
 
+// Rows returns an iterator over the rows of CSV data parsed like ParseCSV.
+// Rows are parsed as the iterator advances, so large responses are processed
+// with constant memory. The first error is yielded with a zero row and ends
+// the iteration. The reader is closed, if it is an io.Closer, when the
+// iteration ends or is stopped early; the iterator can only be used once.
+//
+//	for price, err := range api.Rows[StockPrice](res.Body, time.UTC) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Println(price.Date, price.Open)
+//	}
+func Rows[T any](r io.Reader, location *time.Location) iter.Seq2[T, error] {
+	return RowsWith[T](r, CSVOptions{Location: location})
+}
+
+// RowsWith is Rows with options; see ParseCSVWith.
+func RowsWith[T any](r io.Reader, options CSVOptions) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var err error
+		for row := range parseCSVRows[T](r, options, errorHandler(options, &err)) {
+			if !yield(row, nil) {
+				return
+			}
+		}
+		if err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
 func ensureReadCloser(r io.Reader) io.ReadCloser {
 	if rc, ok := r.(io.ReadCloser); ok {
 		return rc
@@ -127,122 +226,123 @@ func ensureReadCloser(r io.Reader) io.ReadCloser {
 //
 // Example usage:
 //
-//	for price := range parseCSVRows[StockPrice](reader, time.UTC, func(err error) bool {
+//	for price := range parseCSVRows[StockPrice](reader, CSVOptions{}, func(err error) bool {
 //	    log.Printf("Parse error: %v", err)
 //	    return true // continue on errors
 //	}) {
 //	    fmt.Printf("Price: %+v\n", price)
 //	}
-func parseCSVRows[T any](r io.Reader, location *time.Location, handleErr func(error) bool) iter.Seq[T] {
+func parseCSVRows[T any](r io.Reader, options CSVOptions, handleErr func(error) bool) iter.Seq[T] {
 	rc := ensureReadCloser(r)
 	return func(yield func(T) bool) {
 		defer func() { _ = rc.Close() }()
-		if location == nil {
-			location = time.UTC
+		location := cmp.Or(options.Location, time.UTC)
+		defaultNulls := options.NullValues
+		if defaultNulls == nil {
+			defaultNulls = DefaultNullValues
 		}
 
-		rowType := reflect.TypeFor[T]()
+		r, err := checkError(rc)
+		if err != nil {
+			handleErr(err)
+			return
+		}
+
+		structType := reflect.TypeFor[T]()
+		if structType.Kind() != reflect.Struct {
+			panic(fmt.Errorf("expected a struct kind: got %s", structType.Kind()))
+		}
+		plan := planFor(structType)
 
 		reader := csv.NewReader(bufio.NewReader(r))
 		reader.TrimLeadingSpace = true
+		reader.ReuseRecord = true
 		header, err := reader.Read()
 		if err != nil {
 			handleErr(err)
 			return
 		}
+		header = slices.Clone(header)
 		reader.FieldsPerRecord = len(header)
 
-		if rowType.Kind() != reflect.Struct {
-			panic(fmt.Errorf("expected a struct kind: got %s", rowType.Kind()))
+		if added, removed := schemaDrift(header, plan.columns); len(added) > 0 || len(removed) > 0 {
+			if options.OnSchemaDrift != nil {
+				options.OnSchemaDrift(added, removed)
+			}
+			if options.Mode == ModeStrict {
+				handleErr(&SchemaError{Added: added, Removed: removed})
+				return
+			}
 		}
-
-		structType := rowType
-
-		columnToField := make(map[int]int, len(header))
-		for columnHeaderIndex, columnHeaderName := range header {
-			for fieldIndex := 0; fieldIndex < structType.NumField(); fieldIndex++ {
-				fieldType := structType.Field(fieldIndex)
-
-				csvTag := fieldType.Tag.Get("column-name")
-				if csvTag != columnHeaderName {
-					continue
-				}
-
-				columnToField[columnHeaderIndex] = fieldIndex
+		columnFields := make([]*fieldPlan, len(header))
+		for columnIndex, name := range header {
+			if i, ok := plan.byColumn[name]; ok {
+				columnFields[columnIndex] = &plan.fields[i]
 			}
 		}
 
+		// rows are decoded in place and copied when yielded
+		var zero T
+		rowPointer := reflect.New(structType)
+		row, rowValue := rowPointer.Interface().(*T), rowPointer.Elem()
 		for rowIndex := 1; ; rowIndex++ {
-			row, err := reader.Read()
+			record, err := reader.Read()
 			if err != nil {
 				if err == io.EOF {
 					return
 				}
+				if errors.Is(err, csv.ErrFieldCount) {
+					if handleErr(&RowError{Row: rowIndex, Err: err}) {
+						continue
+					}
+					return
+				}
 				handleErr(err)
 				return
 			}
 
-			structValue := reflect.New(structType)
-
-			for columnIndex, value := range row {
-				fieldIndex, ok := columnToField[columnIndex]
-				if !ok {
+			*row = zero
+			for columnIndex, value := range record {
+				field := columnFields[columnIndex]
+				if field == nil {
 					continue
 				}
-
-				structFieldType := structType.Field(fieldIndex)
-
-				switch structFieldType.Type.Kind() {
-				case reflect.String:
-					structValue.Elem().Field(fieldIndex).SetString(value)
-				case reflect.Float64:
-					fl, err := strconv.ParseFloat(value, 64)
-					if err != nil {
-						if handleErr(fmt.Errorf("failed to parse float64 value %q on row %d column %d (%s): %w", value, rowIndex, columnIndex, header[columnIndex], err)) {
-							continue
-						}
-						return
-					}
-					structValue.Elem().Field(fieldIndex).SetFloat(fl)
-				case reflect.Int:
-					in, err := strconv.ParseInt(value, 10, 64)
-					if err != nil {
-						if !handleErr(fmt.Errorf("failed to parse float64 value %q on row %d column %d (%s): %w", value, rowIndex, columnIndex, header[columnIndex], err)) {
-							continue
-						}
-						return
-					}
-					structValue.Elem().Field(fieldIndex).SetInt(in)
-				default:
-					if structFieldType.Type != typeType {
-						if handleErr(fmt.Errorf("unsupported type %T for field %s", structFieldType.Type, structFieldType.Name)) {
-							continue
-						}
-						return
-					}
-
-					layout := DefaultDateFormat
-					tagLayout := structFieldType.Tag.Get("time-layout")
-					if tagLayout != "" {
-						layout = tagLayout
-					}
-					if value == "null" {
+				if err := field.set(rowValue.Field(field.index), value, location, defaultNulls); err != nil {
+					if handleErr(&RowError{Row: rowIndex, Column: header[columnIndex], Value: value, Err: fmt.Errorf("failed to parse %s: %w", field.typ, err)}) {
 						continue
 					}
-					tm, err := time.ParseInLocation(layout, value, location)
-					if err != nil {
-						if handleErr(fmt.Errorf("failed to parse time value on row %d column %d (%s): %w", rowIndex, columnIndex, header[columnIndex], err)) {
-							continue
-						}
-						return
-					}
-					structValue.Elem().Field(fieldIndex).Set(reflect.ValueOf(tm))
+					return
 				}
 			}
 
-			if !yield(structValue.Elem().Interface().(T)) {
+			if !yield(*row) {
 				return
 			}
 		}
 	}
 }
+
+// parseTime parses value with the first matching layout.
+// The error from the last layout is returned when none match.
+// Layouts as long as the value are tried first: a failed parse allocates its
+// error, and a numeric layout usually has the length of the values it formats.
+func parseTime(layouts []string, value string, location *time.Location) (time.Time, error) {
+	if len(layouts) > 1 {
+		for _, layout := range layouts {
+			if len(layout) == len(value) {
+				if tm, err := time.ParseInLocation(layout, value, location); err == nil {
+					return tm, nil
+				}
+			}
+		}
+	}
+	var err error
+	for _, layout := range layouts {
+		var tm time.Time
+		tm, err = time.ParseInLocation(layout, value, location)
+		if err == nil {
+			return tm, nil
+		}
+	}
+	return time.Time{}, err
+}
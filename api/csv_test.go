@@ -13,6 +13,7 @@ import (
 	"github.com/portfoliotree/alphavantage/query/options"
 	"github.com/portfoliotree/alphavantage/query/technical"
 	"github.com/portfoliotree/alphavantage/query/timeseries"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"os"
 	"path/filepath"
@@ -26,6 +27,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.ChaikinADOscillatorRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("ADXR_bc985409", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_directional/ADXR_bc985409.csv"))
@@ -33,6 +37,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.AverageDirectionalMovementIndexRatingRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("ADX_a8d5fbcc", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_directional/ADX_a8d5fbcc.csv"))
@@ -40,6 +47,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.AverageDirectionalMovementIndexRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("AD_207c5d92", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_volume/AD_207c5d92.csv"))
@@ -47,6 +57,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.ChaikinADLineRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("ALL_COMMODITIES_4033e3d9", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/commodities/ALL_COMMODITIES_4033e3d9.csv"))
@@ -54,6 +67,9 @@ func TestCSV(t *testing.T) {
 		var rows []commodities.AllRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("ALUMINUM_85383733", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/commodities/ALUMINUM_85383733.csv"))
@@ -61,6 +77,9 @@ func TestCSV(t *testing.T) {
 		var rows []commodities.AluminumRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("APO_87e8859c", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_momentum/APO_87e8859c.csv"))
@@ -68,6 +87,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.AbsolutePriceOscillatorRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("AROONOSC_9945ac9d", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_directional/AROONOSC_9945ac9d.csv"))
@@ -75,6 +97,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.AroonOscRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("AROON_de4ed20e", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_directional/AROON_de4ed20e.csv"))
@@ -82,6 +107,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.AroonRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("ATR_4cc3ee79", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_volatility/ATR_4cc3ee79.csv"))
@@ -89,6 +117,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.AverageTrueRangeRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("BBANDS_9bd4a9ab", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_volatility/BBANDS_9bd4a9ab.csv"))
@@ -96,6 +127,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.BollingerBandsRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("BOP_088a5081", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_momentum/BOP_088a5081.csv"))
@@ -103,6 +137,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.BalanceOfPowerRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("BRENT_44bdfc84", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/commodities/BRENT_44bdfc84.csv"))
@@ -110,6 +147,9 @@ func TestCSV(t *testing.T) {
 		var rows []commodities.BrentRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("CCI_dd423e9c", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_momentum/CCI_dd423e9c.csv"))
@@ -117,6 +157,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.CommodityChannelIndexRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("CMO_b6a98564", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_momentum/CMO_b6a98564.csv"))
@@ -124,6 +167,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.ChandeMomentumOscillatorRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("COFFEE_24b082fc", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/commodities/COFFEE_24b082fc.csv"))
@@ -131,6 +177,9 @@ func TestCSV(t *testing.T) {
 		var rows []commodities.CoffeeRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("COPPER_639627b4", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/commodities/COPPER_639627b4.csv"))
@@ -138,6 +187,9 @@ func TestCSV(t *testing.T) {
 		var rows []commodities.CopperRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("CORN_3d3a03fa", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/commodities/CORN_3d3a03fa.csv"))
@@ -145,6 +197,9 @@ func TestCSV(t *testing.T) {
 		var rows []commodities.CornRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("COTTON_63260621", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/commodities/COTTON_63260621.csv"))
@@ -152,6 +207,9 @@ func TestCSV(t *testing.T) {
 		var rows []commodities.CottonRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("CPI_453fbf7c", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/economic/CPI_453fbf7c.csv"))
@@ -159,6 +217,9 @@ func TestCSV(t *testing.T) {
 		var rows []economic.ConsumerPriceIndexRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("CRYPTO_INTRADAY_38f15660", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/crypto/CRYPTO_INTRADAY_38f15660.csv"))
@@ -166,6 +227,9 @@ func TestCSV(t *testing.T) {
 		var rows []crypto.IntradayRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("DEMA_42b33e8d", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_moving_averages/DEMA_42b33e8d.csv"))
@@ -173,6 +237,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.DoubleExponentialMovingAverageRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("DIGITAL_CURRENCY_DAILY_ffab6e21", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/crypto/DIGITAL_CURRENCY_DAILY_ffab6e21.csv"))
@@ -180,6 +247,9 @@ func TestCSV(t *testing.T) {
 		var rows []crypto.DigitalCurrencyDailyRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("DIGITAL_CURRENCY_MONTHLY_708fbbc6", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/crypto/DIGITAL_CURRENCY_MONTHLY_708fbbc6.csv"))
@@ -187,6 +257,9 @@ func TestCSV(t *testing.T) {
 		var rows []crypto.DigitalCurrencyMonthlyRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("DIGITAL_CURRENCY_WEEKLY_b80e3492", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/crypto/DIGITAL_CURRENCY_WEEKLY_b80e3492.csv"))
@@ -194,6 +267,9 @@ func TestCSV(t *testing.T) {
 		var rows []crypto.DigitalCurrencyWeeklyRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("DIVIDENDS_5b9f5d9f", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/fundamental/DIVIDENDS_5b9f5d9f.csv"))
@@ -201,6 +277,9 @@ func TestCSV(t *testing.T) {
 		var rows []fundamental.DividendsRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("DURABLES_e4cfe1f5", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/economic/DURABLES_e4cfe1f5.csv"))
@@ -208,6 +287,9 @@ func TestCSV(t *testing.T) {
 		var rows []economic.DurablesRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("DX_51079af1", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_directional/DX_51079af1.csv"))
@@ -215,6 +297,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.DirectionalMovementIndexRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("EARNINGS_CALENDAR_c674ae28", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/fundamental/EARNINGS_CALENDAR_c674ae28.csv"))
@@ -222,6 +307,9 @@ func TestCSV(t *testing.T) {
 		var rows []fundamental.EarningsCalendarRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("EMA_725a3e7b", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_moving_averages/EMA_725a3e7b.csv"))
@@ -229,6 +317,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.ExponentialMovingAverageRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("FEDERAL_FUNDS_RATE_e4372b7a", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/economic/FEDERAL_FUNDS_RATE_e4372b7a.csv"))
@@ -236,6 +327,9 @@ func TestCSV(t *testing.T) {
 		var rows []economic.FederalFundsRateRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("FX_DAILY_b7946c44", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/forex/FX_DAILY_b7946c44.csv"))
@@ -243,6 +337,9 @@ func TestCSV(t *testing.T) {
 		var rows []forex.DailyRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("FX_INTRADAY_f0be5c32", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/forex/FX_INTRADAY_f0be5c32.csv"))
@@ -250,6 +347,9 @@ func TestCSV(t *testing.T) {
 		var rows []forex.IntradayRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("FX_MONTHLY_63bfd9fd", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/forex/FX_MONTHLY_63bfd9fd.csv"))
@@ -257,6 +357,9 @@ func TestCSV(t *testing.T) {
 		var rows []forex.MonthlyRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("FX_WEEKLY_e2a3f5ad", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/forex/FX_WEEKLY_e2a3f5ad.csv"))
@@ -264,6 +367,9 @@ func TestCSV(t *testing.T) {
 		var rows []forex.WeeklyRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("GLOBAL_QUOTE_db7544f0", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/time_series/GLOBAL_QUOTE_db7544f0.csv"))
@@ -271,6 +377,9 @@ func TestCSV(t *testing.T) {
 		var rows []timeseries.GlobalQuoteRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("HISTORICAL_OPTIONS_6c0b1a36", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/options/HISTORICAL_OPTIONS_6c0b1a36.csv"))
@@ -278,6 +387,9 @@ func TestCSV(t *testing.T) {
 		var rows []options.HistoricalRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("HT_DCPERIOD_1f51b571", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_hilbert/HT_DCPERIOD_1f51b571.csv"))
@@ -285,6 +397,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.HilbertTransformDCPeriodRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("HT_DCPHASE_7072d4d1", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_hilbert/HT_DCPHASE_7072d4d1.csv"))
@@ -292,6 +407,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.HilbertTransformDCPhaseRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("HT_PHASOR_5030e26f", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_hilbert/HT_PHASOR_5030e26f.csv"))
@@ -299,6 +417,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.HilbertTransformPhasorRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("HT_SINE_015dabd4", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_hilbert/HT_SINE_015dabd4.csv"))
@@ -306,6 +427,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.HilbertTransformSineRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("HT_TRENDLINE_459bf2f7", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_hilbert/HT_TRENDLINE_459bf2f7.csv"))
@@ -313,6 +437,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.HilbertTransformTrendLineRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("HT_TRENDMODE_90313721", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_hilbert/HT_TRENDMODE_90313721.csv"))
@@ -320,6 +447,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.HilbertTransformTrendModeRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("INFLATION_ae098c06", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/economic/INFLATION_ae098c06.csv"))
@@ -327,6 +457,9 @@ func TestCSV(t *testing.T) {
 		var rows []economic.InflationRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("IPO_CALENDAR_bed45bed", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/fundamental/IPO_CALENDAR_bed45bed.csv"))
@@ -334,6 +467,9 @@ func TestCSV(t *testing.T) {
 		var rows []fundamental.IPOCalendarRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("KAMA_b92e803d", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_moving_averages/KAMA_b92e803d.csv"))
@@ -341,6 +477,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.KaufmanAdaptiveMovingAverageRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("LISTING_STATUS_42ba3c24", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/fundamental/LISTING_STATUS_42ba3c24.csv"))
@@ -348,6 +487,9 @@ func TestCSV(t *testing.T) {
 		var rows []fundamental.ListingStatusRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("MACDEXT_84eb70e4", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_macd_stoch/MACDEXT_84eb70e4.csv"))
@@ -355,6 +497,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.MovingAverageConvergenceDivergenceExtRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("MACD_19e73cc7", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_macd_stoch/MACD_19e73cc7.csv"))
@@ -362,6 +507,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.MovingAverageConvergenceDivergenceRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("MAMA_f93f21b3", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_special_ma/MAMA_f93f21b3.csv"))
@@ -369,6 +517,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.MESAAdaptiveMovingAverageRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("MFI_648317da", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_volume/MFI_648317da.csv"))
@@ -376,6 +527,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.MoneyFlowIndexRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("MIDPOINT_c735d994", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_price/MIDPOINT_c735d994.csv"))
@@ -383,6 +537,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.MidPointRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("MIDPRICE_00f6d973", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_price/MIDPRICE_00f6d973.csv"))
@@ -390,6 +547,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.MidPriceRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("MINUS_DI_c854c442", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_directional/MINUS_DI_c854c442.csv"))
@@ -397,6 +557,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.MinusDirectionalIndicatorRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("MINUS_DM_225feb25", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_directional/MINUS_DM_225feb25.csv"))
@@ -404,6 +567,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.MinusDirectionalMovementRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("MOM_4be2b205", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_momentum/MOM_4be2b205.csv"))
@@ -411,6 +577,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.MomentumRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("NATR_26008ea8", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_volatility/NATR_26008ea8.csv"))
@@ -418,6 +587,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.NormalizedAverageTrueRangeRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("NATURAL_GAS_4be4453d", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/commodities/NATURAL_GAS_4be4453d.csv"))
@@ -425,6 +597,9 @@ func TestCSV(t *testing.T) {
 		var rows []commodities.NaturalGasRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("NONFARM_PAYROLL_cf8e3bcd", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/economic/NONFARM_PAYROLL_cf8e3bcd.csv"))
@@ -432,6 +607,9 @@ func TestCSV(t *testing.T) {
 		var rows []economic.NonFarmPayrollRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("OBV_454e2ac4", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_volume/OBV_454e2ac4.csv"))
@@ -439,6 +617,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.OnBalanceVolumeRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("PLUS_DI_993eebda", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_directional/PLUS_DI_993eebda.csv"))
@@ -446,6 +627,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.PlusDirectionalIndicatorRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("PLUS_DM_e0682566", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_directional/PLUS_DM_e0682566.csv"))
@@ -453,6 +637,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.PlusDirectionalMovementRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("PPO_7bbc8407", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_momentum/PPO_7bbc8407.csv"))
@@ -460,6 +647,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.PercentagePriceOscillatorRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("REAL_GDP_73a04ece", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/economic/REAL_GDP_73a04ece.csv"))
@@ -467,6 +657,9 @@ func TestCSV(t *testing.T) {
 		var rows []economic.RealGDPRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("REAL_GDP_PER_CAPITA_57884c33", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/economic/REAL_GDP_PER_CAPITA_57884c33.csv"))
@@ -474,6 +667,9 @@ func TestCSV(t *testing.T) {
 		var rows []economic.RealGDPPerCapitaRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("RETAIL_SALES_c609adfe", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/economic/RETAIL_SALES_c609adfe.csv"))
@@ -481,6 +677,9 @@ func TestCSV(t *testing.T) {
 		var rows []economic.RetailSalesRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("ROCR_c9cd207d", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_momentum/ROCR_c9cd207d.csv"))
@@ -488,6 +687,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.RateOfChangeRatioRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("ROC_9ffc04fa", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_momentum/ROC_9ffc04fa.csv"))
@@ -495,6 +697,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.RateOfChangeRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("RSI_fbad53b2", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_macd_stoch/RSI_fbad53b2.csv"))
@@ -502,6 +707,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.RelativeStrengthIndexRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("SAR_c1dff5a5", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_volatility/SAR_c1dff5a5.csv"))
@@ -509,6 +717,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.SARRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("SHARES_OUTSTANDING_696752e2", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/fundamental/SHARES_OUTSTANDING_696752e2.csv"))
@@ -516,6 +727,9 @@ func TestCSV(t *testing.T) {
 		var rows []fundamental.SharesOutstandingRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("SMA_2e3849d5", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_moving_averages/SMA_2e3849d5.csv"))
@@ -523,6 +737,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.SimpleMovingAverageRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("SPLITS_358e2618", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/fundamental/SPLITS_358e2618.csv"))
@@ -530,6 +747,9 @@ func TestCSV(t *testing.T) {
 		var rows []fundamental.SplitsRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("STOCHF_aae33090", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_macd_stoch/STOCHF_aae33090.csv"))
@@ -537,6 +757,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.StochasticFastRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("STOCHRSI_87df549e", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_macd_stoch/STOCHRSI_87df549e.csv"))
@@ -544,6 +767,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.StochasticRelativeStrengthIndexRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("STOCH_18a572fd", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_macd_stoch/STOCH_18a572fd.csv"))
@@ -551,6 +777,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.StochasticOscillatorRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("SUGAR_9675b985", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/commodities/SUGAR_9675b985.csv"))
@@ -558,6 +787,9 @@ func TestCSV(t *testing.T) {
 		var rows []commodities.SugarRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("SYMBOL_SEARCH_c3e00cd4", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/time_series/SYMBOL_SEARCH_c3e00cd4.csv"))
@@ -565,6 +797,9 @@ func TestCSV(t *testing.T) {
 		var rows []timeseries.SymbolSearchRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("T3_8ad10afc", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_moving_averages/T3_8ad10afc.csv"))
@@ -572,6 +807,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.T3Row
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("TEMA_c7d8425e", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_moving_averages/TEMA_c7d8425e.csv"))
@@ -579,6 +817,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.TripleExponentialMovingAverageRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("TIME_SERIES_DAILY_42a08190", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/time_series/TIME_SERIES_DAILY_42a08190.csv"))
@@ -586,6 +827,9 @@ func TestCSV(t *testing.T) {
 		var rows []timeseries.DailyRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("TIME_SERIES_DAILY_ADJUSTED_572d0539", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/time_series/TIME_SERIES_DAILY_ADJUSTED_572d0539.csv"))
@@ -593,6 +837,9 @@ func TestCSV(t *testing.T) {
 		var rows []timeseries.DailyAdjustedRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("TIME_SERIES_INTRADAY_3dbdcdc7", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/time_series/TIME_SERIES_INTRADAY_3dbdcdc7.csv"))
@@ -600,6 +847,9 @@ func TestCSV(t *testing.T) {
 		var rows []timeseries.IntradayRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("TIME_SERIES_MONTHLY_3ced87e9", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/time_series/TIME_SERIES_MONTHLY_3ced87e9.csv"))
@@ -607,6 +857,9 @@ func TestCSV(t *testing.T) {
 		var rows []timeseries.MonthlyRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("TIME_SERIES_MONTHLY_ADJUSTED_62a3844d", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/time_series/TIME_SERIES_MONTHLY_ADJUSTED_62a3844d.csv"))
@@ -614,6 +867,9 @@ func TestCSV(t *testing.T) {
 		var rows []timeseries.MonthlyAdjustedRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("TIME_SERIES_WEEKLY_74eb54d6", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/time_series/TIME_SERIES_WEEKLY_74eb54d6.csv"))
@@ -621,6 +877,9 @@ func TestCSV(t *testing.T) {
 		var rows []timeseries.WeeklyRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("TIME_SERIES_WEEKLY_ADJUSTED_9cd0a0b1", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/time_series/TIME_SERIES_WEEKLY_ADJUSTED_9cd0a0b1.csv"))
@@ -628,6 +887,9 @@ func TestCSV(t *testing.T) {
 		var rows []timeseries.WeeklyAdjustedRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("TRANGE_61f61d8e", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_volatility/TRANGE_61f61d8e.csv"))
@@ -635,6 +897,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.TrueRangeRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("TREASURY_YIELD_a4d134a9", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/economic/TREASURY_YIELD_a4d134a9.csv"))
@@ -642,6 +907,11 @@ func TestCSV(t *testing.T) {
 		var rows []economic.TreasuryYieldRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		var written []economic.TreasuryYieldRow
+		require.NoError(t, api.ParseCSV(&out, &written, nil))
+		assert.Equal(t, rows, written)
 	})
 	t.Run("TRIMA_44737774", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_moving_averages/TRIMA_44737774.csv"))
@@ -649,6 +919,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.TriangularMovingAverageRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("TRIX_5d057c38", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_volume/TRIX_5d057c38.csv"))
@@ -656,6 +929,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.OneDayRateOfChangeTripleSmoothExponentialMovingAverageRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("ULTOSC_b91aa1db", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_volatility/ULTOSC_b91aa1db.csv"))
@@ -663,6 +939,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.UltimateOscillatorRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("UNEMPLOYMENT_a0671528", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/economic/UNEMPLOYMENT_a0671528.csv"))
@@ -670,6 +949,9 @@ func TestCSV(t *testing.T) {
 		var rows []economic.UnemploymentRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("VWAP_2cb5b135", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_special_ma/VWAP_2cb5b135.csv"))
@@ -677,6 +959,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.VolumeWeightedAveragePriceRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("WHEAT_92552a45", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/commodities/WHEAT_92552a45.csv"))
@@ -684,6 +969,9 @@ func TestCSV(t *testing.T) {
 		var rows []commodities.WheatRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("WILLR_6d7a9722", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_momentum/WILLR_6d7a9722.csv"))
@@ -691,6 +979,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.WilliamsRRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("WMA_b6c190fa", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/technical_moving_averages/WMA_b6c190fa.csv"))
@@ -698,6 +989,9 @@ func TestCSV(t *testing.T) {
 		var rows []technical.WeightedMovingAverageRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 	t.Run("WTI_6498cf9f", func(t *testing.T) {
 		buf, err := os.ReadFile(filepath.FromSlash("../specification/testdata/examples/commodities/WTI_6498cf9f.csv"))
@@ -705,5 +999,8 @@ func TestCSV(t *testing.T) {
 		var rows []commodities.WestTexasIntermediateRow
 		err = api.ParseCSV(bytes.NewReader(buf), &rows, nil)
 		require.NoError(t, err)
+		var out bytes.Buffer
+		require.NoError(t, api.WriteCSV(&out, rows))
+		assert.Equal(t, string(buf), out.String())
 	})
 }
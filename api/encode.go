@@ -0,0 +1,235 @@
+package api
+
+import (
+	"cmp"
+	"encoding"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CSVMarshaler is implemented by field types that format their own CSV
+// values. It takes precedence over the built-in encoding and
+// encoding.TextMarshaler.
+type CSVMarshaler interface {
+	MarshalCSV() (string, error)
+}
+
+var (
+	csvMarshalerType  = reflect.TypeFor[CSVMarshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// WriteCSV writes a header and rows in the format ParseCSV reads; see
+// Encoder.
+func WriteCSV[T any](w io.Writer, rows []T) error {
+	e := NewEncoder[T](w)
+	if err := e.WriteHeader(); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := e.Encode(row); err != nil {
+			return err
+		}
+	}
+	return e.Flush()
+}
+
+// Encoder writes row structs as CSV one row at a time. Like ParseCSV it maps
+// fields to columns with `column-name` tags, in field order, and honors:
+//   - `time-layout:"layout"`: times are written with the shortest of the "|"
+//     separated layouts that keeps the time, so "2006-01-02 15:04|2006-01-02"
+//     writes dates without a time of day
+//   - `decimals:"n"`: floats (including Percent and Ratio) are written with at
+//     least n decimals and more when needed to keep the value, so
+//     `decimals:"4"` writes 218.2 as "218.2000"
+//   - `null:"values"`: missing values are written as the first value
+//   - `suffix:"text"`: floats are followed by text, so `suffix:"%"` writes
+//     the Percent 0.4259 as "0.4259%"
+//
+// A value is missing when it is a NaN float, a nil pointer or a zero
+// time.Time; it is written as the empty string without a null tag. Types
+// whose pointer implements CSVMarshaler or encoding.TextMarshaler format
+// themselves.
+type Encoder[T any] struct {
+	w      *csv.Writer
+	plan   *encodePlan
+	record []string
+}
+
+// NewEncoder returns an Encoder writing to w. T must be a struct type.
+func NewEncoder[T any](w io.Writer) *Encoder[T] {
+	structType := reflect.TypeFor[T]()
+	if structType.Kind() != reflect.Struct {
+		panic(fmt.Errorf("expected a struct kind: got %s", structType.Kind()))
+	}
+	plan := encodePlanFor(structType)
+	return &Encoder[T]{
+		w:      csv.NewWriter(w),
+		plan:   plan,
+		record: make([]string, len(plan.fields)),
+	}
+}
+
+// WriteHeader writes the column names. Call it before the first Encode unless
+// appending to data that already has a header.
+func (e *Encoder[T]) WriteHeader() error {
+	return e.w.Write(e.plan.header)
+}
+
+// Encode writes one row. Rows are buffered; call Flush when done.
+func (e *Encoder[T]) Encode(row T) error {
+	// the copy of row is addressable for marshalers with pointer receivers
+	v := reflect.ValueOf(&row).Elem()
+	for i := range e.plan.fields {
+		f := &e.plan.fields[i]
+		value, err := f.encode(v.Field(f.index))
+		if err != nil {
+			return fmt.Errorf("failed to encode column %s: %w", e.plan.header[i], err)
+		}
+		e.record[i] = value
+	}
+	return e.w.Write(e.record)
+}
+
+// Flush writes buffered rows to the underlying writer.
+func (e *Encoder[T]) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type encodePlan struct {
+	header []string
+	fields []fieldEncoder
+}
+
+type fieldEncoder struct {
+	index  int
+	encode func(v reflect.Value) (string, error)
+}
+
+var encodePlans sync.Map // reflect.Type → *encodePlan
+
+func encodePlanFor(structType reflect.Type) *encodePlan {
+	if plan, ok := encodePlans.Load(structType); ok {
+		return plan.(*encodePlan)
+	}
+	plan := new(encodePlan)
+	for fieldIndex := range structType.NumField() {
+		structField := structType.Field(fieldIndex)
+		column := structField.Tag.Get("column-name")
+		if column == "" {
+			continue
+		}
+		null, _, _ := strings.Cut(structField.Tag.Get("null"), "|")
+		plan.header = append(plan.header, column)
+		plan.fields = append(plan.fields, fieldEncoder{
+			index:  fieldIndex,
+			encode: newEncoder(structField.Type, structField, null),
+		})
+	}
+	actual, _ := encodePlans.LoadOrStore(structType, plan)
+	return actual.(*encodePlan)
+}
+
+func newEncoder(t reflect.Type, structField reflect.StructField, null string) func(reflect.Value) (string, error) {
+	if t.Kind() == reflect.Pointer {
+		elem := newEncoder(t.Elem(), structField, null)
+		return func(v reflect.Value) (string, error) {
+			if v.IsNil() {
+				return null, nil
+			}
+			return elem(v.Elem())
+		}
+	}
+
+	if reflect.PointerTo(t).Implements(csvMarshalerType) {
+		return func(v reflect.Value) (string, error) {
+			return v.Addr().Interface().(CSVMarshaler).MarshalCSV()
+		}
+	}
+	switch t {
+	case typeType:
+		layouts := strings.Split(cmp.Or(structField.Tag.Get("time-layout"), DefaultDateFormat), "|")
+		if len(layouts) > 1 {
+			// try the shortest layouts first
+			layouts = slices.Clone(layouts)
+			slices.SortStableFunc(layouts, func(a, b string) int { return cmp.Compare(len(a), len(b)) })
+		}
+		return func(v reflect.Value) (string, error) {
+			tm := v.Interface().(time.Time)
+			if tm.IsZero() {
+				return null, nil
+			}
+			return formatTime(layouts, tm), nil
+		}
+	case durationType:
+		return func(v reflect.Value) (string, error) {
+			return time.Duration(v.Int()).String(), nil
+		}
+	}
+	if reflect.PointerTo(t).Implements(textMarshalerType) {
+		return func(v reflect.Value) (string, error) {
+			text, err := v.Addr().Interface().(encoding.TextMarshaler).MarshalText()
+			return string(text), err
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return func(v reflect.Value) (string, error) { return v.String(), nil }
+	case reflect.Bool:
+		return func(v reflect.Value) (string, error) { return strconv.FormatBool(v.Bool()), nil }
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(v reflect.Value) (string, error) { return strconv.FormatInt(v.Int(), 10), nil }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return func(v reflect.Value) (string, error) { return strconv.FormatUint(v.Uint(), 10), nil }
+	case reflect.Float32, reflect.Float64:
+		decimals, _ := strconv.Atoi(structField.Tag.Get("decimals"))
+		bits := t.Bits()
+		suffix := structField.Tag.Get("suffix")
+		return func(v reflect.Value) (string, error) {
+			f := v.Float()
+			if math.IsNaN(f) {
+				return null, nil
+			}
+			return formatFloat(f, bits, decimals) + suffix, nil
+		}
+	}
+	err := fmt.Errorf("unsupported type %s for field %s", t, structField.Name)
+	return func(reflect.Value) (string, error) { return "", err }
+}
+
+// formatTime formats tm with the first layout that keeps the time.
+func formatTime(layouts []string, tm time.Time) string {
+	for _, layout := range layouts[:len(layouts)-1] {
+		s := tm.Format(layout)
+		if parsed, err := time.ParseInLocation(layout, s, tm.Location()); err == nil && parsed.Equal(tm) {
+			return s
+		}
+	}
+	return tm.Format(layouts[len(layouts)-1])
+}
+
+// formatFloat formats f with at least decimals digits after the point.
+func formatFloat(f float64, bits, decimals int) string {
+	s := strconv.FormatFloat(f, 'f', -1, bits)
+	if decimals <= 0 || math.IsInf(f, 0) {
+		return s
+	}
+	point := strings.IndexByte(s, '.')
+	if point < 0 {
+		return s + "." + strings.Repeat("0", decimals)
+	}
+	if have := len(s) - point - 1; have < decimals {
+		return s + strings.Repeat("0", decimals-have)
+	}
+	return s
+}
@@ -2,8 +2,16 @@ package api
 
 import (
 	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
 	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -21,4 +29,510 @@ func Test_checkError(t *testing.T) {
 		_, err := checkError(rc)
 		require.ErrorContains(t, err, "Could not satisfy")
 	})
+
+	t.Run("premium endpoint", func(t *testing.T) {
+		rc := io.NopCloser(bytes.NewBufferString(`{"endpoint": "Realtime Bulk Quotes", "message": "This is a premium endpoint. ***THE SAMPLE DATA SCHEMA BELOW IS ARTIFICIAL AND FOR ILLUSTRATION PURPOSES ONLY***.", "data": []}`))
+		_, err := checkError(rc)
+		require.ErrorIs(t, err, ErrPremiumEndpoint)
+	})
+}
+
+func TestParseRatio(t *testing.T) {
+	for _, s := range []string{"2.0000", "2:1", "2/1", "2-for-1", " 4:2 "} {
+		r, err := ParseRatio(s)
+		require.NoError(t, err, s)
+		require.Equal(t, Ratio(2), r, s)
+	}
+	_, err := ParseRatio("1:0")
+	require.Error(t, err)
+	_, err = ParseRatio("two")
+	require.Error(t, err)
+}
+
+func TestParseCSV_missingFloat(t *testing.T) {
+	var rows []struct {
+		Value float64 `column-name:"value"`
+	}
+	require.NoError(t, ParseCSV(bytes.NewBufferString("timestamp,value\n2024-01-02,4.1\n2024-01-01,.\n"), &rows, nil))
+	require.Len(t, rows, 2)
+	require.Equal(t, 4.1, rows[0].Value)
+	require.True(t, math.IsNaN(rows[1].Value))
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestRows(t *testing.T) {
+	type row struct {
+		Value float64 `column-name:"value"`
+	}
+	body := &closeRecorder{Reader: bytes.NewBufferString("timestamp,value\n2024-01-03,1\n2024-01-02,2\n2024-01-01,3\n")}
+	var values []float64
+	for r, err := range Rows[row](body, nil) {
+		require.NoError(t, err)
+		values = append(values, r.Value)
+		if len(values) == 2 {
+			break
+		}
+	}
+	require.Equal(t, []float64{1, 2}, values)
+	require.True(t, body.closed, "stopping early closes the reader")
+
+	var errs []error
+	for r, err := range Rows[row](bytes.NewBufferString("timestamp,value\n2024-01-03,1\n2024-01-02,x\n2024-01-01,3\n"), nil) {
+		if err != nil {
+			require.Zero(t, r)
+			errs = append(errs, err)
+		}
+	}
+	require.Len(t, errs, 1, "the first error ends the iteration")
+	require.ErrorContains(t, errs[0], `"x"`)
+
+	for _, err := range Rows[row](bytes.NewBufferString(`{"Information": "rate limit"}`), nil) {
+		require.ErrorContains(t, err, "rate limit")
+	}
+}
+
+type upperText string
+
+func (u *upperText) UnmarshalText(text []byte) error {
+	*u = upperText(strings.ToUpper(string(text)))
+	return nil
+}
+
+type cents int64
+
+func (c *cents) UnmarshalCSV(value string) error {
+	f, err := strconv.ParseFloat(value, 64)
+	*c = cents(math.Round(f * 100))
+	return err
+}
+
+func (c *cents) MarshalCSV() (string, error) {
+	return strconv.FormatFloat(float64(*c)/100, 'f', 2, 64), nil
+}
+
+func TestParseCSV_fieldTypes(t *testing.T) {
+	type row struct {
+		Active   bool          `column-name:"active"`
+		Volume   int64         `column-name:"volume"`
+		Shares   uint64        `column-name:"shares"`
+		Price    float32       `column-name:"price"`
+		Delay    time.Duration `column-name:"delay"`
+		Change   Percent       `column-name:"change"`
+		Name     upperText     `column-name:"name"`
+		Amount   cents         `column-name:"amount"`
+		Dividend *float64      `column-name:"dividend"`
+		Paid     *time.Time    `column-name:"paid"`
+	}
+	var rows []row
+	require.NoError(t, ParseCSV(strings.NewReader(
+		"active,volume,shares,price,delay,change,name,amount,dividend,paid\n"+
+			"true,9000000000,18446744073709551615,1.5,15m,2.5%,ibm,12.34,0.25,2024-01-02\n"+
+			"false,0,0,0,1s,0,x,0,,.\n"), &rows, nil))
+	require.Len(t, rows, 2)
+	require.Equal(t, true, rows[0].Active)
+	require.Equal(t, int64(9000000000), rows[0].Volume)
+	require.Equal(t, uint64(math.MaxUint64), rows[0].Shares)
+	require.Equal(t, float32(1.5), rows[0].Price)
+	require.Equal(t, 15*time.Minute, rows[0].Delay)
+	require.Equal(t, Percent(2.5), rows[0].Change)
+	require.Equal(t, upperText("IBM"), rows[0].Name, "TextUnmarshaler is used")
+	require.Equal(t, cents(1234), rows[0].Amount, "CSVUnmarshaler is used")
+	require.NotNil(t, rows[0].Dividend)
+	require.Equal(t, 0.25, *rows[0].Dividend)
+	require.NotNil(t, rows[0].Paid)
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), *rows[0].Paid)
+	require.Nil(t, rows[1].Dividend, "empty values leave pointers nil")
+	require.Nil(t, rows[1].Paid, `"." leaves pointers nil`)
+
+	var bad []struct {
+		Values []int `column-name:"values"`
+	}
+	require.ErrorContains(t, ParseCSV(strings.NewReader("values\n1\n"), &bad, nil), "unsupported type")
+	var overflow []struct {
+		Small int8 `column-name:"small"`
+	}
+	require.ErrorContains(t, ParseCSV(strings.NewReader("small\n300\n"), &overflow, nil), "out of range")
+}
+
+func TestParseCSVWith_nulls(t *testing.T) {
+	type row struct {
+		Name   string   `column-name:"name"`
+		Price  float64  `column-name:"price"`
+		Shares int      `column-name:"shares"`
+		Yield  *float64 `column-name:"yield"`
+		Rating float64  `column-name:"rating" null:"N/A"`
+	}
+	const data = "name,price,shares,yield,rating\n" +
+		"None,.,-,None,N/A\n" +
+		"-,,None,-,1\n" +
+		"x,1.5,2,0.5,2\n"
+	var rows []row
+	require.NoError(t, ParseCSV(strings.NewReader(data), &rows, nil))
+	require.Len(t, rows, 3)
+	for _, r := range rows[:2] {
+		require.True(t, math.IsNaN(r.Price))
+		require.Zero(t, r.Shares)
+		require.Nil(t, r.Yield)
+	}
+	require.Equal(t, []string{"None", "-", "x"}, []string{rows[0].Name, rows[1].Name, rows[2].Name}, "strings keep their value")
+	require.True(t, math.IsNaN(rows[0].Rating), "the null tag replaces the default values")
+	require.Equal(t, 1.5, rows[2].Price)
+	require.Equal(t, 0.5, *rows[2].Yield)
+
+	err := ParseCSV(strings.NewReader("rating\n.\n"), &[]row{}, nil)
+	require.ErrorContains(t, err, `"."`, "the tag does not include the default values")
+
+	err = ParseCSVWith(strings.NewReader(data), &[]row{}, CSVOptions{NullValues: []string{"."}})
+	require.ErrorContains(t, err, `"-"`)
+	var custom []row
+	require.NoError(t, ParseCSVWith(strings.NewReader("price,shares\nn/a,n/a\n"), &custom, CSVOptions{NullValues: []string{"n/a"}}))
+	require.True(t, math.IsNaN(custom[0].Price))
+}
+
+func TestParseCSVWith_modes(t *testing.T) {
+	type row struct {
+		Date   string  `column-name:"timestamp"`
+		Close  float64 `column-name:"close"`
+		Volume int     `column-name:"volume"`
+	}
+	const data = "timestamp,close,volume,vwap\n" +
+		"2024-01-03,1.5,x,1\n" +
+		"2024-01-02,1.4\n" +
+		"2024-01-01,y,30,1\n"
+
+	var rows []row
+	err := ParseCSV(strings.NewReader(data), &rows, nil)
+	var rowErr *RowError
+	require.ErrorAs(t, err, &rowErr, "an int that fails to parse stops the default mode")
+	require.Equal(t, 1, rowErr.Row)
+	require.Equal(t, "volume", rowErr.Column)
+	require.Equal(t, "x", rowErr.Value)
+	require.ErrorContains(t, err, "int")
+	require.Empty(t, rows)
+
+	var report Report
+	rows = nil
+	require.NoError(t, ParseCSVWith(strings.NewReader(data), &rows, CSVOptions{Mode: ModeLenient, Report: &report}))
+	require.Equal(t, []row{{Date: "2024-01-03", Close: 1.5}, {Date: "2024-01-01", Volume: 30}}, rows)
+	require.Len(t, report.Errors, 3)
+	require.Equal(t, []int{1, 2, 3}, []int{report.Errors[0].Row, report.Errors[1].Row, report.Errors[2].Row})
+	require.ErrorIs(t, report.Errors[1], csv.ErrFieldCount)
+	require.Equal(t, "close", report.Errors[2].Column)
+	require.ErrorContains(t, report.Err(), `"y"`)
+
+	var added, removed []string
+	drift := func(a, r []string) { added, removed = a, r }
+	var schemaErr *SchemaError
+	err = ParseCSVWith(strings.NewReader("timestamp,close,vwap\n2024-01-01,1,1\n"), &[]row{}, CSVOptions{Mode: ModeStrict, OnSchemaDrift: drift})
+	require.ErrorAs(t, err, &schemaErr)
+	require.Equal(t, []string{"vwap"}, schemaErr.Added)
+	require.Equal(t, []string{"volume"}, schemaErr.Removed)
+	require.Equal(t, []string{"vwap"}, added)
+	require.Equal(t, []string{"volume"}, removed)
+
+	added, removed = nil, nil
+	rows = nil
+	require.NoError(t, ParseCSVWith(strings.NewReader("timestamp,close,volume\n2024-01-01,1,2\n"), &rows, CSVOptions{Mode: ModeStrict, OnSchemaDrift: drift}))
+	require.Len(t, rows, 1)
+	require.Nil(t, added, "the callback is only called on drift")
+	require.Nil(t, removed)
+}
+
+func TestParseCSV_allocations(t *testing.T) {
+	type row struct {
+		Time   time.Time `column-name:"timestamp" time-layout:"2006-01-02 15:04:05|2006-01-02"`
+		Close  float64   `column-name:"close"`
+		Volume int64     `column-name:"volume"`
+		Split  Ratio     `column-name:"split"`
+	}
+	var data bytes.Buffer
+	data.WriteString("timestamp,close,volume,split,dividend\n")
+	const rows = 1000
+	for i := range rows {
+		fmt.Fprintf(&data, "2024-01-%02d,%d.25,%d,1.0,0\n", i%28+1, i, i*100)
+	}
+	require.Same(t, planFor(reflect.TypeFor[row]()), planFor(reflect.TypeFor[row]()), "plans are cached")
+
+	allocs := testing.AllocsPerRun(10, func() {
+		for _, err := range Rows[row](bytes.NewReader(data.Bytes()), nil) {
+			require.NoError(t, err)
+		}
+	})
+	require.Less(t, allocs/rows, 1.1, "decoding a row allocates only its record")
+}
+
+func TestWriteCSV(t *testing.T) {
+	type row struct {
+		Time     time.Time     `column-name:"timestamp" time-layout:"2006-01-02 15:04:05|2006-01-02"`
+		Close    float64       `column-name:"close" decimals:"4"`
+		Volume   int64         `column-name:"volume"`
+		Change   Percent       `column-name:"change" decimals:"4" suffix:"%"`
+		Split    Ratio         `column-name:"split" decimals:"1"`
+		Dividend *float64      `column-name:"dividend"`
+		Paid     time.Time     `column-name:"paid" null:"None|."`
+		Active   bool          `column-name:"active"`
+		Delay    time.Duration `column-name:"delay"`
+		Amount   cents         `column-name:"amount"`
+		Ignored  string
+	}
+	dividend := 0.25
+	rows := []row{
+		{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Close: 218.2, Volume: 100, Change: 0.4259, Split: 1, Dividend: &dividend, Paid: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Active: true, Delay: 15 * time.Minute, Amount: 1234},
+		{Time: time.Date(2024, 1, 2, 9, 30, 0, 0, time.UTC), Close: 1.23456, Change: -1, Split: 1.0460, Paid: time.Time{}},
+		{Close: math.NaN()},
+	}
+	var out bytes.Buffer
+	require.NoError(t, WriteCSV(&out, rows))
+	require.Equal(t, "timestamp,close,volume,change,split,dividend,paid,active,delay,amount\n"+
+		"2024-01-02,218.2000,100,0.4259%,1.0,0.25,2024-02-01,true,15m0s,12.34\n"+
+		"2024-01-02 09:30:00,1.23456,0,-1.0000%,1.046,,None,false,0s,0.00\n"+
+		",,0,0.0000%,0.0,,None,false,0s,0.00\n", out.String())
+
+	var parsed []row
+	require.NoError(t, ParseCSV(&out, &parsed, nil))
+	require.Equal(t, rows[:2], parsed[:2], "written rows parse back")
+	require.True(t, math.IsNaN(parsed[2].Close))
+
+	var bad bytes.Buffer
+	require.ErrorContains(t, WriteCSV(&bad, []struct {
+		Values []int `column-name:"values"`
+	}{{}}), "unsupported type")
+}
+
+func TestEncoder(t *testing.T) {
+	type row struct {
+		Date  time.Time `column-name:"timestamp"`
+		Value float64   `column-name:"value" decimals:"2"`
+	}
+	var out bytes.Buffer
+	e := NewEncoder[row](&out)
+	require.NoError(t, e.Encode(row{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Value: 4.1}))
+	require.NoError(t, e.Flush())
+	require.Equal(t, "2024-01-01,4.10\n", out.String(), "rows can be appended without a header")
+	require.NoError(t, e.Encode(row{Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Value: 1e21}))
+	require.NoError(t, e.Flush())
+	require.Equal(t, "2024-01-01,4.10\n2024-01-02,1000000000000000000000.00\n", out.String())
+
+	require.Panics(t, func() { NewEncoder[int](&out) })
+}
+
+func TestWriteJSONL(t *testing.T) {
+	type row struct {
+		Time     time.Time     `column-name:"timestamp" time-layout:"2006-01-02 15:04:05"`
+		Upper    float64       `column-name:"Real Upper Band" decimals:"4"`
+		Hist     float64       `column-name:"MACD_Hist"`
+		FastK    float32       `column-name:"FastK"`
+		Contract string        `column-name:"contractID"`
+		Change   Percent       `column-name:"changePercent"`
+		Dividend *float64      `column-name:"dividend amount"`
+		Paid     time.Time     `column-name:"payment_date"`
+		Volume   uint64        `column-name:"volume"`
+		Active   bool          `column-name:"active"`
+		Delay    time.Duration `column-name:"delay"`
+		Amount   cents         `column-name:"amount"`
+		Ignored  string
+	}
+	eastern := time.FixedZone("EST", -5*60*60)
+	dividend := 0.25
+	rows := []row{
+		{Time: time.Date(2024, 1, 2, 9, 30, 0, 0, eastern), Upper: 218.2, Hist: -0.5, FastK: 0.1, Contract: `IBM"C`, Change: 0.4259, Dividend: &dividend, Paid: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Volume: 100, Active: true, Delay: 90 * time.Second, Amount: 1234},
+		{Upper: math.NaN(), Hist: math.Inf(1)},
+	}
+	var out bytes.Buffer
+	require.NoError(t, WriteJSONL(&out, rows))
+	require.Equal(t, `{"timestamp":"2024-01-02T09:30:00-05:00","real_upper_band":218.2,"macd_hist":-0.5,"fast_k":0.1,"contract_id":"IBM\"C","change_percent":0.4259,"dividend_amount":0.25,"payment_date":"2024-02-01T00:00:00Z","volume":100,"active":true,"delay":"1m30s","amount":"12.34"}`+"\n"+
+		`{"timestamp":null,"real_upper_band":null,"macd_hist":null,"fast_k":0,"contract_id":"","change_percent":0,"dividend_amount":null,"payment_date":null,"volume":0,"active":false,"delay":"0s","amount":"0.00"}`+"\n", out.String())
+
+	for line := range strings.Lines(out.String()) {
+		var object map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &object))
+	}
+
+	require.ErrorContains(t, WriteJSONL(&out, []struct {
+		Values []int `column-name:"values"`
+	}{{}}), "unsupported type")
+	require.Panics(t, func() { NewJSONLEncoder[int](&out) })
+}
+
+func Test_snakeCase(t *testing.T) {
+	for name, want := range map[string]string{
+		"timestamp":        "timestamp",
+		"adjusted close":   "adjusted_close",
+		"HT_DCPHASE":       "ht_dcphase",
+		"Chaikin A/D":      "chaikin_a_d",
+		"fiscalDateEnding": "fiscal_date_ending",
+		"HTTPStatus":       "http_status",
+		"SlowD":            "slow_d",
+		"ema20":            "ema20",
+	} {
+		require.Equal(t, want, snakeCase(name), name)
+	}
+}
+
+func TestFunctionLocation(t *testing.T) {
+	eastern, err := Eastern()
+	require.NoError(t, err, "the time zone database is embedded")
+	require.Equal(t, "America/New_York", eastern.String())
+	again, err := Eastern()
+	require.NoError(t, err)
+	require.Same(t, eastern, again, "one location for the market")
+
+	for _, tt := range []struct {
+		function, interval string
+		location           *time.Location
+	}{
+		{"TIME_SERIES_INTRADAY", "5min", eastern},
+		{"SMA", "15min", eastern},
+		{"TIME_SERIES_DAILY", "", time.UTC},
+		{"SMA", "daily", time.UTC},
+		{"FX_INTRADAY", "5min", time.UTC},
+		{"CRYPTO_INTRADAY", "1min", time.UTC},
+	} {
+		location, err := FunctionLocation(tt.function, tt.interval)
+		require.NoError(t, err)
+		require.Same(t, tt.location, location, "%s %s", tt.function, tt.interval)
+	}
+}
+
+func TestParseJSONSeries(t *testing.T) {
+	t.Run("time series", func(t *testing.T) {
+		type row struct {
+			TimeStamp     time.Time `column-name:"timestamp" time-layout:"2006-01-02 15:04:05|2006-01-02"`
+			Close         float64   `column-name:"close"`
+			AdjustedClose float64   `column-name:"adjusted_close"`
+			Volume        int       `column-name:"volume"`
+			Split         Ratio     `column-name:"split_coefficient"`
+		}
+		const data = `{
+    "Meta Data": {
+        "1. Information": "Daily Time Series with Splits and Dividend Events",
+        "2. Symbol": "IBM",
+        "3. Last Refreshed": "2024-06-07",
+        "4. Output Size": "Compact",
+        "5. Time Zone": "US/Eastern"
+    },
+    "Time Series (Daily)": {
+        "2024-06-07": {
+            "1. open": "168.1800",
+            "4. close": "170.0100",
+            "5. adjusted close": "169.5000",
+            "6. volume": "3475495",
+            "7. dividend amount": "0.0000",
+            "8. split coefficient": "1.0"
+        },
+        "2024-06-06": {
+            "4. close": "170.7600",
+            "5. adjusted close": "170.2500",
+            "6. volume": "2541183",
+            "8. split coefficient": "2.0"
+        }
+    }
+}`
+		var rows []row
+		meta, err := ParseJSONSeries(strings.NewReader(data), &rows, nil)
+		require.NoError(t, err)
+		require.Equal(t, "IBM", meta.Symbol)
+		require.Equal(t, "Compact", meta.OutputSize)
+		require.Equal(t, "US/Eastern", meta.TimeZone)
+		require.Equal(t, "Daily Time Series with Splits and Dividend Events", meta.Information)
+		require.Equal(t, time.Date(2024, 6, 7, 0, 0, 0, 0, time.UTC), meta.LastRefreshed)
+		require.Equal(t, []row{
+			{TimeStamp: time.Date(2024, 6, 7, 0, 0, 0, 0, time.UTC), Close: 170.01, AdjustedClose: 169.5, Volume: 3475495, Split: 1},
+			{TimeStamp: time.Date(2024, 6, 6, 0, 0, 0, 0, time.UTC), Close: 170.76, AdjustedClose: 170.25, Volume: 2541183, Split: 2},
+		}, rows, "rows keep the response order and dates are UTC, as in CSV responses")
+
+		eastern, err := Eastern()
+		require.NoError(t, err)
+		rows = nil
+		_, err = ParseJSONSeries(strings.NewReader(data), &rows, eastern)
+		require.NoError(t, err)
+		require.Equal(t, eastern, rows[0].TimeStamp.Location(), "the location argument is used for dates too")
+	})
+	t.Run("intraday", func(t *testing.T) {
+		type row struct {
+			TimeStamp time.Time `column-name:"timestamp" time-layout:"2006-01-02 15:04:05"`
+			Close     float64   `column-name:"close"`
+		}
+		const data = `{
+    "Meta Data": {"2. Symbol": "IBM", "3. Last Refreshed": "2024-06-07 19:55:00", "4. Interval": "5min", "6. Time Zone": "US/Eastern"},
+    "Time Series (5min)": {"2024-06-07 19:55:00": {"4. close": "170.0100"}}
+}`
+		var rows []row
+		meta, err := ParseJSONSeries(strings.NewReader(data), &rows, nil)
+		require.NoError(t, err)
+		eastern, err := time.LoadLocation("US/Eastern")
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2024, 6, 7, 19, 55, 0, 0, eastern), meta.LastRefreshed)
+		require.Equal(t, []row{{TimeStamp: time.Date(2024, 6, 7, 19, 55, 0, 0, eastern), Close: 170.01}}, rows, "times of day use the metadata time zone")
+
+		rows = nil
+		_, err = ParseJSONSeries(strings.NewReader(data), &rows, time.UTC)
+		require.NoError(t, err)
+		require.Equal(t, time.UTC, rows[0].TimeStamp.Location(), "the location argument is used over the metadata")
+	})
+	t.Run("technical indicator", func(t *testing.T) {
+		type row struct {
+			Time time.Time `column-name:"time" time-layout:"2006-01-02 15:04|2006-01-02"`
+			Hist float64   `column-name:"MACD_Hist"`
+		}
+		var rows []row
+		meta, err := ParseJSONSeries(strings.NewReader(`{
+    "Meta Data": {"1: Symbol": "IBM", "3: Last Refreshed": "2024-06-07 15:30", "4: Interval": "30min", "5.1: Fast Period": 12, "7: Time Zone": "US/Eastern Time"},
+    "Technical Analysis: MACD": {"2024-06-07 15:30": {"MACD_Hist": "-0.0542", "MACD": "1.2"}}
+}`), &rows, nil)
+		require.NoError(t, err)
+		require.Equal(t, "IBM", meta.Symbol)
+		require.Equal(t, "30min", meta.Interval)
+		require.Equal(t, "US/Eastern", meta.TimeZone, "the time zone has the name CSV series report")
+		require.Equal(t, "12", meta.Fields["fast_period"], "entries without a field are kept by normalized name")
+		eastern, err := time.LoadLocation("US/Eastern")
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2024, 6, 7, 15, 30, 0, 0, eastern), meta.LastRefreshed, "a last refreshed time without seconds is parsed")
+		require.Equal(t, []row{{Time: time.Date(2024, 6, 7, 15, 30, 0, 0, eastern), Hist: -0.0542}}, rows)
+	})
+	t.Run("economic data", func(t *testing.T) {
+		type row struct {
+			TimeStamp time.Time `column-name:"timestamp"`
+			Value     float64   `column-name:"value"`
+		}
+		var rows []row
+		meta, err := ParseJSONSeries(io.NopCloser(strings.NewReader(`{"name": "Consumer Price Index for all Urban Consumers", "interval": "monthly", "unit": "index 1982-1984=100",
+    "data": [{"date": "2024-05-01", "value": "314.069"}, {"date": "2024-04-01", "value": "."}]}`)), &rows, nil)
+		require.NoError(t, err)
+		require.Equal(t, "monthly", meta.Interval)
+		require.Equal(t, "index 1982-1984=100", meta.Fields["unit"])
+		require.Len(t, rows, 2)
+		require.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), rows[0].TimeStamp)
+		require.Equal(t, 314.069, rows[0].Value)
+		require.True(t, math.IsNaN(rows[1].Value), "null values are missing")
+	})
+	t.Run("errors", func(t *testing.T) {
+		type row struct {
+			TimeStamp time.Time `column-name:"timestamp"`
+			Volume    int       `column-name:"volume"`
+		}
+		_, err := ParseJSONSeries(strings.NewReader(`{"Information": "Thank you for using Alpha Vantage! This is a premium endpoint."}`), &[]row{}, nil)
+		require.ErrorIs(t, err, ErrPremiumEndpoint)
+		_, err = ParseJSONSeries(strings.NewReader(`{"Error Message": "Invalid API call."}`), &[]row{}, nil)
+		require.ErrorContains(t, err, "Invalid API call.")
+		_, err = ParseJSONSeries(strings.NewReader(`{"symbol": "IBM"}`), &[]row{}, nil)
+		require.ErrorContains(t, err, "no time series")
+
+		var rows []row
+		_, err = ParseJSONSeries(strings.NewReader(`{"Time Series (Daily)": {"2024-06-07": {"5. volume": "1"}, "2024-06-06": {"5. volume": "x"}}}`), &rows, nil)
+		var rowErr *RowError
+		require.ErrorAs(t, err, &rowErr)
+		require.Equal(t, 2, rowErr.Row)
+		require.Equal(t, "5. volume", rowErr.Column)
+		require.Len(t, rows, 1)
+	})
 }
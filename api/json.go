@@ -0,0 +1,339 @@
+package api
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// lastRefreshedLayouts are the layouts of the "Last Refreshed" entry.
+var lastRefreshedLayouts = []string{"2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+
+// ParseJSONSeries parses a JSON time series response (datatype=json) into the
+// row structs ParseCSV uses and returns its metadata.
+//
+// The response has a "Meta Data" object and an object of entries keyed by
+// timestamp, such as "Time Series (Daily)" or "Technical Analysis: SMA".
+// Economic and commodity responses, with an array of entries under "data",
+// are supported too. Entry keys are matched to column-name tags by
+// normalized name: the "1. " or "1: " prefix is removed, letters are
+// lowercased and spaces become underscores, so "5. adjusted close" sets the
+// field tagged `column-name:"adjusted_close"`. The timestamp key, or the
+// "date" of a data entry, sets the field of the "timestamp", "time" or "date"
+// column.
+//
+// Times without a zone are parsed in location. When it is nil, dates are
+// parsed in UTC and times of day in the time zone of the metadata, defaulting
+// to UTC, like FunctionLocation does for CSV responses. Rows keep the order
+// of the response, newest first. Missing values are handled as in ParseCSV.
+//
+// If the body is a notice instead of a time series (for example a rate
+// limit or premium endpoint message) the notice is returned as an error.
+func ParseJSONSeries[T any](r io.Reader, data *[]T, location *time.Location) (Meta, error) {
+	if data == nil {
+		panic(fmt.Errorf("data must not be nil"))
+	}
+	structType := reflect.TypeFor[T]()
+	if structType.Kind() != reflect.Struct {
+		panic(fmt.Errorf("expected a struct kind: got %s", structType.Kind()))
+	}
+	defer func() {
+		if rc, ok := r.(io.Closer); ok {
+			_ = rc.Close()
+		}
+	}()
+
+	row := new(T)
+	s := newJSONSeries(planFor(structType), location, reflect.ValueOf(row).Elem(), func() {
+		*data = append(*data, *row)
+	})
+	err := s.parse(json.NewDecoder(r))
+	return s.meta, err
+}
+
+// jsonSeries decodes the entries of a JSON time series into row and calls
+// add after each one.
+type jsonSeries struct {
+	// fields maps normalized column names to fields.
+	fields    map[string]*fieldPlan
+	timeField *fieldPlan
+	// location is nil until rowLocation resolves it.
+	location *time.Location
+	// dates is the location of dates without a time of day.
+	dates  *time.Location
+	row    reflect.Value
+	add    func()
+	rows   int
+	meta   Meta
+	notice notice
+}
+
+func newJSONSeries(plan *decodePlan, location *time.Location, row reflect.Value, add func()) *jsonSeries {
+	s := &jsonSeries{
+		fields:   make(map[string]*fieldPlan, len(plan.fields)),
+		location: location,
+		dates:    cmp.Or(location, time.UTC),
+		row:      row,
+		add:      add,
+		meta:     Meta{Fields: make(map[string]string)},
+	}
+	for i, column := range plan.columns {
+		s.fields[normalizeJSONKey(column)] = &plan.fields[i]
+	}
+	s.timeField = plan.timeField()
+	return s
+}
+
+func (s *jsonSeries) parse(d *json.Decoder) error {
+	d.UseNumber()
+	if err := expectDelim(d, '{'); err != nil {
+		return err
+	}
+	found := false
+	err := readObject(d, func(key string) error {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		delim, ok := token.(json.Delim)
+		switch {
+		case !ok:
+			s.setTopLevel(key, jsonString(token))
+			return nil
+		case key == "Meta Data" && delim == '{':
+			return s.parseMeta(d)
+		case delim == '{':
+			found = true
+			return readObject(d, func(timestamp string) error {
+				return s.parseEntry(d, timestamp)
+			})
+		case key == "data" && delim == '[':
+			found = true
+			for d.More() {
+				if err := s.parseEntry(d, ""); err != nil {
+					return err
+				}
+			}
+			_, err := d.Token()
+			return err
+		default:
+			return skipValue(d)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		if err := s.notice.err("a time series"); err != nil {
+			return err
+		}
+		return errors.New("alphavantage response has no time series")
+	}
+	return nil
+}
+
+func (s *jsonSeries) parseMeta(d *json.Decoder) error {
+	err := readObject(d, func(key string) error {
+		var value any
+		if err := d.Decode(&value); err != nil {
+			return err
+		}
+		s.setMeta(normalizeJSONKey(key), jsonString(value))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if value, ok := s.meta.Fields["last_refreshed"]; ok {
+		if tm, err := parseTime(lastRefreshedLayouts, value, s.valueLocation(value)); err == nil {
+			s.meta.LastRefreshed = tm
+		}
+	}
+	return nil
+}
+
+// setTopLevel records values outside "Meta Data". Economic series keep
+// their metadata there and notices are single top level entries.
+func (s *jsonSeries) setTopLevel(key, value string) {
+	switch key {
+	case "Note":
+		s.notice.Note = value
+	case "Information":
+		s.notice.Information = value
+	case "Error Message":
+		s.notice.ErrorMessage = value
+	case "detail":
+		s.notice.Detail = value
+	case "message":
+		s.notice.Message = value
+	default:
+		s.setMeta(normalizeJSONKey(key), value)
+	}
+}
+
+func (s *jsonSeries) setMeta(name, value string) {
+	s.meta.Fields[name] = value
+	switch name {
+	case "information":
+		s.meta.Information = value
+	case "symbol":
+		s.meta.Symbol = value
+	case "interval":
+		s.meta.Interval = value
+	case "output_size":
+		s.meta.OutputSize = value
+	case "time_zone":
+		// technical indicators report "US/Eastern Time"
+		s.meta.TimeZone = strings.TrimSuffix(value, " Time")
+	}
+}
+
+// rowLocation returns the location times without a zone are parsed in. It
+// is resolved once, so the metadata must come before the entries, as it does
+// in AlphaVantage responses.
+func (s *jsonSeries) rowLocation() *time.Location {
+	if s.location == nil {
+		s.location = time.UTC
+		if location, err := s.meta.Location(); err == nil {
+			s.location = location
+		}
+	}
+	return s.location
+}
+
+// valueLocation returns the location value is parsed in: dates use dates
+// and times of day use rowLocation.
+func (s *jsonSeries) valueLocation(value string) *time.Location {
+	if len(value) == len(time.DateOnly) {
+		return s.dates
+	}
+	return s.rowLocation()
+}
+
+func (s *jsonSeries) parseEntry(d *json.Decoder, timestamp string) error {
+	var entry map[string]any
+	if err := d.Decode(&entry); err != nil {
+		return err
+	}
+	s.rows++
+	s.row.SetZero()
+	if s.timeField != nil && timestamp != "" {
+		if err := s.set(s.timeField, "timestamp", timestamp, s.valueLocation(timestamp)); err != nil {
+			return err
+		}
+	}
+	for key, value := range entry {
+		name := normalizeJSONKey(key)
+		f, ok := s.fields[name]
+		if !ok && name == "date" {
+			f, ok = s.timeField, s.timeField != nil
+		}
+		if !ok {
+			continue
+		}
+		text := jsonString(value)
+		if err := s.set(f, key, text, s.valueLocation(text)); err != nil {
+			return err
+		}
+	}
+	s.add()
+	return nil
+}
+
+func (s *jsonSeries) set(f *fieldPlan, key, value string, location *time.Location) error {
+	if err := f.set(s.row.Field(f.index), value, location, DefaultNullValues); err != nil {
+		return &RowError{Row: s.rows, Column: key, Value: value, Err: fmt.Errorf("failed to parse %s: %w", f.typ, err)}
+	}
+	return nil
+}
+
+// normalizeJSONKey removes the "1. ", "5.1: " or "1a. " prefix AlphaVantage
+// numbers JSON keys with, lowercases the key and replaces spaces with
+// underscores.
+func normalizeJSONKey(key string) string {
+	i := 0
+	for i < len(key) && ('0' <= key[i] && key[i] <= '9' || key[i] == '.') {
+		i++
+	}
+	if i > 0 && i < len(key) && 'a' <= key[i] && key[i] <= 'z' {
+		i++
+	}
+	if i > 0 && i < len(key) && (key[i] == '.' || key[i] == ':') {
+		i++
+	}
+	if i > 1 && (key[i-1] == '.' || key[i-1] == ':') && i < len(key) && key[i] == ' ' {
+		key = strings.TrimLeft(key[i:], " ")
+	}
+	return strings.ReplaceAll(strings.ToLower(key), " ", "_")
+}
+
+// jsonString returns a decoded JSON scalar as text; null is empty.
+func jsonString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func expectDelim(d *json.Decoder, delim json.Delim) error {
+	token, err := d.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %s in JSON: got %v", delim, token)
+	}
+	return nil
+}
+
+// readObject calls readValue for each key of an object whose opening brace
+// was read. readValue must read the value of the key.
+func readObject(d *json.Decoder, readValue func(key string) error) error {
+	for d.More() {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := token.(string)
+		if !ok {
+			return fmt.Errorf("expected an object key in JSON: got %v", token)
+		}
+		if err := readValue(key); err != nil {
+			return err
+		}
+	}
+	_, err := d.Token()
+	return err
+}
+
+// skipValue reads the rest of an object or array whose opening delimiter was
+// read.
+func skipValue(d *json.Decoder) error {
+	for depth := 1; depth > 0; {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+	}
+	return nil
+}
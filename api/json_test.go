@@ -0,0 +1,36 @@
+package api_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/portfoliotree/alphavantage/api"
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+)
+
+func TestParseJSONSeries_REALTIME_BULK_QUOTES(t *testing.T) {
+	f, err := os.Open(filepath.FromSlash("../specification/testdata/examples/time_series/REALTIME_BULK_QUOTES_505ed5cc.json"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+	var rows []timeseries.RealtimeBulkQuotesRow
+	_, err = api.ParseJSONSeries(f, &rows, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, rows)
+	assert.Equal(t, api.Percent(0.3456), rows[0].ChangePercent)
+
+	var out bytes.Buffer
+	require.NoError(t, api.WriteCSV(&out, rows))
+	lines := strings.Split(out.String(), "\n")
+	assert.Equal(t, "MSFT,2024-10-18 19:59:55.291,417.61,419.649,416.2601,418.16,17145307,416.72,1.44,0.3456,418.1,-0.06,-0.01435", lines[1],
+		"percentages are written without a suffix, as Alpha Vantage writes them")
+
+	var written []timeseries.RealtimeBulkQuotesRow
+	require.NoError(t, api.ParseCSV(&out, &written, nil))
+	assert.Equal(t, rows, written)
+}
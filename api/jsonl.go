@@ -0,0 +1,225 @@
+package api
+
+import (
+	"bufio"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+var jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+
+// WriteJSONL writes rows as newline-delimited JSON; see JSONLEncoder.
+func WriteJSONL[T any](w io.Writer, rows []T) error {
+	e := NewJSONLEncoder[T](w)
+	for _, row := range rows {
+		if err := e.Encode(row); err != nil {
+			return err
+		}
+	}
+	return e.Flush()
+}
+
+// JSONLEncoder writes row structs as newline-delimited JSON, one object per
+// row. Fields with a `column-name` tag become keys in field order; the key is
+// the column name in snake_case, so "Real Upper Band" is "real_upper_band",
+// "MACD_Hist" is "macd_hist" and "fiscalDateEnding" is "fiscal_date_ending".
+//
+// Times are RFC 3339 strings, floats and integers are numbers (Percent values
+// in percent) and durations are strings like "1m30s". Missing values, NaN and
+// infinite floats, nil pointers and zero times, are null. Types whose pointer
+// implements json.Marshaler format themselves; CSVMarshaler and
+// encoding.TextMarshaler values are strings.
+type JSONLEncoder[T any] struct {
+	w    *bufio.Writer
+	plan *jsonlPlan
+	line []byte
+}
+
+// NewJSONLEncoder returns a JSONLEncoder writing to w. T must be a struct
+// type.
+func NewJSONLEncoder[T any](w io.Writer) *JSONLEncoder[T] {
+	structType := reflect.TypeFor[T]()
+	if structType.Kind() != reflect.Struct {
+		panic(fmt.Errorf("expected a struct kind: got %s", structType.Kind()))
+	}
+	return &JSONLEncoder[T]{
+		w:    bufio.NewWriter(w),
+		plan: jsonlPlanFor(structType),
+	}
+}
+
+// Encode writes one row. Rows are buffered; call Flush when done.
+func (e *JSONLEncoder[T]) Encode(row T) error {
+	// the copy of row is addressable for marshalers with pointer receivers
+	v := reflect.ValueOf(&row).Elem()
+	line := append(e.line[:0], '{')
+	for i := range e.plan.fields {
+		f := &e.plan.fields[i]
+		if i > 0 {
+			line = append(line, ',')
+		}
+		line = append(line, f.key...)
+		var err error
+		line, err = f.encode(line, v.Field(f.index))
+		if err != nil {
+			return fmt.Errorf("failed to encode column %s: %w", f.column, err)
+		}
+	}
+	line = append(line, '}', '\n')
+	e.line = line
+	_, err := e.w.Write(line)
+	return err
+}
+
+// Flush writes buffered rows to the underlying writer.
+func (e *JSONLEncoder[T]) Flush() error {
+	return e.w.Flush()
+}
+
+type jsonlPlan struct {
+	fields []jsonlField
+}
+
+type jsonlField struct {
+	index  int
+	column string
+	// key is the quoted key and the colon.
+	key    []byte
+	encode func(line []byte, v reflect.Value) ([]byte, error)
+}
+
+var jsonlPlans sync.Map // reflect.Type → *jsonlPlan
+
+func jsonlPlanFor(structType reflect.Type) *jsonlPlan {
+	if plan, ok := jsonlPlans.Load(structType); ok {
+		return plan.(*jsonlPlan)
+	}
+	plan := new(jsonlPlan)
+	for fieldIndex := range structType.NumField() {
+		structField := structType.Field(fieldIndex)
+		column := structField.Tag.Get("column-name")
+		if column == "" {
+			continue
+		}
+		plan.fields = append(plan.fields, jsonlField{
+			index:  fieldIndex,
+			column: column,
+			key:    append(appendJSONString(nil, snakeCase(column)), ':'),
+			encode: newJSONLEncoder(structField.Type, structField),
+		})
+	}
+	actual, _ := jsonlPlans.LoadOrStore(structType, plan)
+	return actual.(*jsonlPlan)
+}
+
+func newJSONLEncoder(t reflect.Type, structField reflect.StructField) func([]byte, reflect.Value) ([]byte, error) {
+	if t.Kind() == reflect.Pointer {
+		elem := newJSONLEncoder(t.Elem(), structField)
+		return func(line []byte, v reflect.Value) ([]byte, error) {
+			if v.IsNil() {
+				return append(line, "null"...), nil
+			}
+			return elem(line, v.Elem())
+		}
+	}
+
+	switch t {
+	case typeType:
+		return func(line []byte, v reflect.Value) ([]byte, error) {
+			tm := v.Interface().(time.Time)
+			if tm.IsZero() {
+				return append(line, "null"...), nil
+			}
+			line = append(line, '"')
+			line = tm.AppendFormat(line, time.RFC3339Nano)
+			return append(line, '"'), nil
+		}
+	case durationType:
+		return func(line []byte, v reflect.Value) ([]byte, error) {
+			return appendJSONString(line, time.Duration(v.Int()).String()), nil
+		}
+	}
+
+	switch {
+	case reflect.PointerTo(t).Implements(jsonMarshalerType):
+		return func(line []byte, v reflect.Value) ([]byte, error) {
+			b, err := v.Addr().Interface().(json.Marshaler).MarshalJSON()
+			return append(line, b...), err
+		}
+	case reflect.PointerTo(t).Implements(csvMarshalerType):
+		return func(line []byte, v reflect.Value) ([]byte, error) {
+			s, err := v.Addr().Interface().(CSVMarshaler).MarshalCSV()
+			return appendJSONString(line, s), err
+		}
+	}
+	if reflect.PointerTo(t).Implements(textMarshalerType) {
+		return func(line []byte, v reflect.Value) ([]byte, error) {
+			text, err := v.Addr().Interface().(encoding.TextMarshaler).MarshalText()
+			return appendJSONString(line, string(text)), err
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return func(line []byte, v reflect.Value) ([]byte, error) { return appendJSONString(line, v.String()), nil }
+	case reflect.Bool:
+		return func(line []byte, v reflect.Value) ([]byte, error) { return strconv.AppendBool(line, v.Bool()), nil }
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(line []byte, v reflect.Value) ([]byte, error) { return strconv.AppendInt(line, v.Int(), 10), nil }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return func(line []byte, v reflect.Value) ([]byte, error) { return strconv.AppendUint(line, v.Uint(), 10), nil }
+	case reflect.Float32, reflect.Float64:
+		bits := t.Bits()
+		return func(line []byte, v reflect.Value) ([]byte, error) {
+			f := v.Float()
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return append(line, "null"...), nil
+			}
+			return strconv.AppendFloat(line, f, 'f', -1, bits), nil
+		}
+	}
+	err := fmt.Errorf("unsupported type %s for field %s", t, structField.Name)
+	return func(line []byte, _ reflect.Value) ([]byte, error) { return line, err }
+}
+
+func appendJSONString(line []byte, s string) []byte {
+	b, _ := json.Marshal(s) // strings always marshal
+	return append(line, b...)
+}
+
+// snakeCase returns a column name in lower snake_case. Spaces and
+// punctuation separate words, as do case changes: "contractID" is
+// "contract_id" and "HTTPStatus" is "http_status".
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	pending := false
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			pending = b.Len() > 0
+			continue
+		}
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			next := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && next) {
+				pending = b.Len() > 0
+			}
+		}
+		if pending {
+			b.WriteByte('_')
+			pending = false
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata" // Eastern must load on systems without a time zone database
+)
+
+// Eastern returns the America/New_York time zone AlphaVantage writes equity
+// timestamps in. The time zone database is embedded, so it loads on systems
+// without one.
+func Eastern() (*time.Location, error) {
+	return loadEastern()
+}
+
+var loadEastern = sync.OnceValues(func() (*time.Location, error) {
+	return time.LoadLocation("America/New_York")
+})
+
+// FunctionLocation returns the time zone of the timestamps AlphaVantage
+// writes for function and interval: Eastern for intraday equity series and
+// technical indicators and UTC for forex and crypto. Dates are UTC so series
+// of different markets line up.
+func FunctionLocation(function, interval string) (*time.Location, error) {
+	if !strings.HasSuffix(function, "_INTRADAY") && !strings.HasSuffix(interval, "min") {
+		return time.UTC, nil
+	}
+	if strings.HasPrefix(function, "FX_") || strings.HasPrefix(function, "CRYPTO_") {
+		return time.UTC, nil
+	}
+	return Eastern()
+}
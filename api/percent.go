@@ -0,0 +1,38 @@
+package api
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Percent is a percentage as AlphaVantage writes it: 0.2723 means 0.2723%.
+// A trailing "%" is accepted when parsing.
+type Percent float64
+
+// ParsePercent parses values like "0.2723%" or "-1.5".
+func ParsePercent(s string) (Percent, error) {
+	f, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(s), "%"), 64)
+	if err != nil {
+		return 0, err
+	}
+	return Percent(f), nil
+}
+
+// UnmarshalCSV implements CSVUnmarshaler with ParsePercent.
+func (p *Percent) UnmarshalCSV(value string) error {
+	v, err := ParsePercent(value)
+	if err != nil {
+		return err
+	}
+	*p = v
+	return nil
+}
+
+// Fraction returns the percentage as a ratio (1.5% is 0.015).
+func (p Percent) Fraction() float64 {
+	return float64(p) / 100
+}
+
+func (p Percent) String() string {
+	return strconv.FormatFloat(float64(p), 'f', -1, 64) + "%"
+}
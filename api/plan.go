@@ -0,0 +1,208 @@
+package api
+
+import (
+	"cmp"
+	"encoding"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CSVUnmarshaler is implemented by field types that parse their own CSV
+// values. It takes precedence over the built-in decoding and
+// encoding.TextUnmarshaler.
+type CSVUnmarshaler interface {
+	UnmarshalCSV(value string) error
+}
+
+var (
+	csvUnmarshalerType  = reflect.TypeFor[CSVUnmarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	durationType        = reflect.TypeFor[time.Duration]()
+)
+
+// decodePlan is how the values of a row struct type are decoded. Plans are
+// built once per type and shared by every parse of that type.
+type decodePlan struct {
+	fields []fieldPlan
+	// columns holds the column-name tag of each field in field order.
+	columns []string
+	// byColumn maps a column name to the index of its field in fields.
+	byColumn map[string]int
+}
+
+type fieldPlan struct {
+	index int
+	typ   reflect.Type
+	// nulls holds the values of the null tag. It is nil when the field uses
+	// the null values of the parse options.
+	nulls []string
+	// nullable is false for string fields, which always get the value.
+	nullable bool
+	decode   decoder
+}
+
+// decoder sets v from a value that is not null.
+type decoder func(v reflect.Value, value string, location *time.Location) error
+
+var decodePlans sync.Map // reflect.Type → *decodePlan
+
+func planFor(structType reflect.Type) *decodePlan {
+	if plan, ok := decodePlans.Load(structType); ok {
+		return plan.(*decodePlan)
+	}
+	plan := &decodePlan{byColumn: make(map[string]int)}
+	for fieldIndex := range structType.NumField() {
+		structField := structType.Field(fieldIndex)
+		column := structField.Tag.Get("column-name")
+		if column == "" {
+			continue
+		}
+		f := fieldPlan{
+			index:    fieldIndex,
+			typ:      structField.Type,
+			nullable: structField.Type.Kind() != reflect.String,
+			decode:   newDecoder(structField.Type, structField),
+		}
+		if tag, ok := structField.Tag.Lookup("null"); ok {
+			f.nulls = strings.Split(tag, "|")
+		}
+		plan.columns = append(plan.columns, column)
+		plan.byColumn[column] = len(plan.fields)
+		plan.fields = append(plan.fields, f)
+	}
+	actual, _ := decodePlans.LoadOrStore(structType, plan)
+	return actual.(*decodePlan)
+}
+
+// set decodes value into the field v. Null values leave pointers nil, set
+// floats to NaN and other types to their zero value.
+func (f *fieldPlan) set(v reflect.Value, value string, location *time.Location, defaultNulls []string) error {
+	if f.nullable {
+		nulls := f.nulls
+		if nulls == nil {
+			nulls = defaultNulls
+		}
+		if slices.Contains(nulls, value) {
+			switch v.Kind() {
+			case reflect.Float32, reflect.Float64:
+				v.SetFloat(math.NaN())
+			default:
+				v.SetZero()
+			}
+			return nil
+		}
+	}
+	return f.decode(v, value, location)
+}
+
+func newDecoder(t reflect.Type, structField reflect.StructField) decoder {
+	if t.Kind() == reflect.Pointer {
+		elem := newDecoder(t.Elem(), structField)
+		return func(v reflect.Value, value string, location *time.Location) error {
+			p := reflect.New(t.Elem())
+			if err := elem(p.Elem(), value, location); err != nil {
+				return err
+			}
+			v.Set(p)
+			return nil
+		}
+	}
+
+	if reflect.PointerTo(t).Implements(csvUnmarshalerType) {
+		return func(v reflect.Value, value string, _ *time.Location) error {
+			return v.Addr().Interface().(CSVUnmarshaler).UnmarshalCSV(value)
+		}
+	}
+	switch t {
+	case typeType:
+		layouts := strings.Split(cmp.Or(structField.Tag.Get("time-layout"), DefaultDateFormat), "|")
+		return func(v reflect.Value, value string, location *time.Location) error {
+			tm, err := parseTime(layouts, value, location)
+			if err != nil {
+				return err
+			}
+			*v.Addr().Interface().(*time.Time) = tm
+			return nil
+		}
+	case durationType:
+		return func(v reflect.Value, value string, _ *time.Location) error {
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				return err
+			}
+			v.SetInt(int64(d))
+			return nil
+		}
+	}
+	if reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return func(v reflect.Value, value string, _ *time.Location) error {
+			return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value))
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return func(v reflect.Value, value string, _ *time.Location) error {
+			v.SetString(value)
+			return nil
+		}
+	case reflect.Bool:
+		return func(v reflect.Value, value string, _ *time.Location) error {
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return err
+			}
+			v.SetBool(b)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bits := t.Bits()
+		return func(v reflect.Value, value string, _ *time.Location) error {
+			in, err := strconv.ParseInt(value, 10, bits)
+			if err != nil {
+				return err
+			}
+			v.SetInt(in)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		bits := t.Bits()
+		return func(v reflect.Value, value string, _ *time.Location) error {
+			u, err := strconv.ParseUint(value, 10, bits)
+			if err != nil {
+				return err
+			}
+			v.SetUint(u)
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		bits := t.Bits()
+		return func(v reflect.Value, value string, _ *time.Location) error {
+			fl, err := strconv.ParseFloat(value, bits)
+			if err != nil {
+				return err
+			}
+			v.SetFloat(fl)
+			return nil
+		}
+	}
+	err := fmt.Errorf("unsupported type %s for field %s", t, structField.Name)
+	return func(reflect.Value, string, *time.Location) error { return err }
+}
+
+// timeField returns the field of the "timestamp", "time" or "date" column
+// or nil when there is none.
+func (plan *decodePlan) timeField() *fieldPlan {
+	for i, column := range plan.columns {
+		if column == "timestamp" || column == "time" || column == "date" {
+			return &plan.fields[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Ratio is a split factor: the number of shares held after a split for each
+// share held before it. A 2-for-1 split is 2 and a 1-for-10 reverse split
+// is 0.1.
+type Ratio float64
+
+// ParseRatio parses a split factor. AlphaVantage writes split factors as
+// decimals ("2.0000"); the forms "2:1", "2/1" and "2-for-1" are also
+// accepted.
+func ParseRatio(s string) (Ratio, error) {
+	s = strings.TrimSpace(s)
+	for _, separator := range []string{":", "/", "-for-"} {
+		after, before, ok := strings.Cut(s, separator)
+		if !ok {
+			continue
+		}
+		numerator, err := strconv.ParseFloat(strings.TrimSpace(after), 64)
+		if err != nil {
+			return 0, err
+		}
+		denominator, err := strconv.ParseFloat(strings.TrimSpace(before), 64)
+		if err != nil {
+			return 0, err
+		}
+		if denominator == 0 {
+			return 0, fmt.Errorf("ratio %q has a zero denominator", s)
+		}
+		return Ratio(numerator / denominator), nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	return Ratio(f), nil
+}
+
+// UnmarshalCSV implements CSVUnmarshaler with ParseRatio.
+func (r *Ratio) UnmarshalCSV(value string) error {
+	v, err := ParseRatio(value)
+	if err != nil {
+		return err
+	}
+	*r = v
+	return nil
+}
+
+func (r Ratio) String() string {
+	return strconv.FormatFloat(float64(r), 'f', -1, 64)
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Mode selects how ParseCSVWith and RowsWith handle CSV data that does not
+// match the row struct.
+type Mode int
+
+const (
+	// ModeDefault ignores columns without a field and fields without a
+	// column and stops at the first value that can not be parsed.
+	ModeDefault Mode = iota
+	// ModeStrict is ModeDefault but also fails with a *SchemaError when the
+	// header has a column without a field or a field's column is missing.
+	ModeStrict
+	// ModeLenient continues after a value that can not be parsed, leaving
+	// its field at the zero value, and after a record with the wrong number
+	// of fields, skipping it. Each problem is added to the Report option.
+	ModeLenient
+)
+
+// RowError is a problem with one row of CSV data. Rows are numbered from 1
+// after the header. Column and Value are empty when the whole record is
+// malformed.
+type RowError struct {
+	Row    int
+	Column string
+	Value  string
+	Err    error
+}
+
+func (e *RowError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("failed to parse row %d: %v", e.Row, e.Err)
+	}
+	return fmt.Sprintf("failed to parse value %q on row %d column %s: %v", e.Value, e.Row, e.Column, e.Err)
+}
+
+func (e *RowError) Unwrap() error { return e.Err }
+
+// Report collects the errors of a ModeLenient parse.
+type Report struct {
+	Errors []*RowError
+}
+
+// Err joins the errors of the report. It is nil when there are none.
+func (r *Report) Err() error {
+	errs := make([]error, len(r.Errors))
+	for i, err := range r.Errors {
+		errs[i] = err
+	}
+	return errors.Join(errs...)
+}
+
+// SchemaError is returned in ModeStrict when the CSV header does not match
+// the column-name tags of the row struct.
+type SchemaError struct {
+	// Added lists the header columns without a field.
+	Added []string
+	// Removed lists the columns of fields missing from the header.
+	Removed []string
+}
+
+func (e *SchemaError) Error() string {
+	var problems []string
+	if len(e.Added) > 0 {
+		problems = append(problems, "unexpected columns "+strings.Join(e.Added, ", "))
+	}
+	if len(e.Removed) > 0 {
+		problems = append(problems, "missing columns "+strings.Join(e.Removed, ", "))
+	}
+	return "csv header does not match the row fields: " + strings.Join(problems, "; ")
+}
+
+// schemaDrift compares the header with the column names of the fields.
+func schemaDrift(header, columns []string) (added, removed []string) {
+	for _, name := range header {
+		if !slices.Contains(columns, name) {
+			added = append(added, name)
+		}
+	}
+	for _, name := range columns {
+		if !slices.Contains(header, name) {
+			removed = append(removed, name)
+		}
+	}
+	return added, removed
+}
+
+// errorHandler returns the handleErr function of parseCSVRows for options.
+// It stores the error that ends the parse in err.
+func errorHandler(options CSVOptions, err *error) func(error) bool {
+	return func(e error) bool {
+		var rowErr *RowError
+		if options.Mode == ModeLenient && errors.As(e, &rowErr) {
+			if options.Report != nil {
+				options.Report.Errors = append(options.Report.Errors, rowErr)
+			}
+			return true
+		}
+		*err = e
+		return false
+	}
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"reflect"
+	"time"
+)
+
+// Series is a time series response: the rows and the metadata AlphaVantage
+// reports with them.
+type Series[T any] struct {
+	Meta Meta
+	Rows []T
+}
+
+// NewSeries returns a Series of rows. When meta.LastRefreshed is zero it is
+// set to the latest time in the "timestamp", "time" or "date" column of T.
+func NewSeries[T any](meta Meta, rows []T) Series[T] {
+	if meta.LastRefreshed.IsZero() {
+		if f := planFor(reflect.TypeFor[T]()).timeField(); f != nil && f.typ == typeType {
+			for i := range rows {
+				tm := reflect.ValueOf(&rows[i]).Elem().Field(f.index).Interface().(time.Time)
+				if tm.After(meta.LastRefreshed) {
+					meta.LastRefreshed = tm
+				}
+			}
+		}
+	}
+	return Series[T]{Meta: meta, Rows: rows}
+}
+
+// Meta describes a time series. ParseJSONSeries reads it from the "Meta
+// Data" block of a JSON response; CSV responses have no metadata, so the
+// client derives it from the query. Entries without a field of their own,
+// such as "From Symbol" or "Time Period", are only in Fields.
+type Meta struct {
+	Information string
+	Symbol      string
+	Interval    string
+	OutputSize  string
+	// TimeZone is the time zone AlphaVantage reports for the series, such
+	// as "US/Eastern" or "UTC", whatever location the rows are parsed in.
+	TimeZone string
+	// LastRefreshed is zero when the response has no last refreshed entry
+	// or it can not be parsed.
+	LastRefreshed time.Time
+	// Fields holds every entry, or query parameter, by its normalized name
+	// (see ParseJSONSeries), for example "time_period" or "from_symbol".
+	Fields map[string]string
+}
+
+// Location loads the TimeZone of the metadata, UTC when it is empty.
+func (m Meta) Location() (*time.Location, error) {
+	if m.TimeZone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(m.TimeZone)
+}
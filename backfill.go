@@ -0,0 +1,116 @@
+package alphavantage
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+)
+
+// MonthStore keeps the intraday rows of complete months so Backfill does not
+// request them again.
+type MonthStore interface {
+	// LoadMonth returns the rows saved for the month starting at month. The
+	// ok result is false when the month has not been saved.
+	LoadMonth(symbol, interval string, month time.Time) (rows []timeseries.IntradayRow, ok bool, err error)
+	// SaveMonth saves the rows of a complete month.
+	SaveMonth(symbol, interval string, month time.Time, rows []timeseries.IntradayRow) error
+}
+
+// Backfill returns the intraday bars from from to to (inclusive) in
+// chronological order. It requests TIME_SERIES_INTRADAY one month at a time
+// with the month parameter, waiting on the client Limiter before each
+// request. Months that store already holds are read from it instead, and
+// each complete month that is downloaded is saved to it; store may be nil.
+//
+// Rows are deduplicated at the month boundaries. When a request fails the
+// error is yielded and the iteration ends. Calling Backfill again with the
+// same store resumes with the first month that was not saved.
+//
+// The wall clocks of from and to are read in the location the client parses
+// intraday rows in (see Client.Location), US/Eastern by default, so
+// time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) starts at midnight in New York.
+func (f *TimeSeriesFunctions) Backfill(ctx context.Context, symbol, interval string, from, to time.Time, store MonthStore) iter.Seq2[timeseries.IntradayRow, error] {
+	return func(yield func(timeseries.IntradayRow, error) bool) {
+		location, err := (*Client)(f).location(url.Values{"function": {"TIME_SERIES_INTRADAY"}, "interval": {interval}})
+		if err != nil {
+			yield(timeseries.IntradayRow{}, fmt.Errorf("failed to load time zone: %w", err))
+			return
+		}
+		from, to = wallClock(from, location), wallClock(to, location)
+		var last time.Time
+		for month := range months(from, to) {
+			rows, err := f.backfillMonth(ctx, symbol, interval, month, store)
+			if err != nil {
+				yield(timeseries.IntradayRow{}, fmt.Errorf("failed to backfill %s %s for %s: %w", symbol, interval, month.Format("2006-01"), err))
+				return
+			}
+			for _, row := range rows {
+				if row.TimeStamp.Before(from) || row.TimeStamp.After(to) || !row.TimeStamp.After(last) {
+					continue
+				}
+				last = row.TimeStamp
+				if !yield(row, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (f *TimeSeriesFunctions) backfillMonth(ctx context.Context, symbol, interval string, month time.Time, store MonthStore) ([]timeseries.IntradayRow, error) {
+	if store != nil {
+		rows, ok, err := store.LoadMonth(symbol, interval, month)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return sortedIntradayRows(rows), nil
+		}
+	}
+	rows, err := f.Intraday(ctx, timeseries.QueryIntraday(f.APIKey, symbol, interval).Month(month).OutputSizeFull().DataTypeCSV())
+	if err != nil {
+		return nil, err
+	}
+	rows = sortedIntradayRows(rows)
+	if store != nil && monthComplete(month, time.Now()) {
+		if err := store.SaveMonth(symbol, interval, month, rows); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+// wallClock returns the time with the wall clock of t in location.
+func wallClock(t time.Time, location *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), location)
+}
+
+// months yields the first day of each month from from to to in the location
+// of from.
+func months(from, to time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		month := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, from.Location())
+		for !month.After(to) {
+			if !yield(month) {
+				return
+			}
+			month = month.AddDate(0, 1, 0)
+		}
+	}
+}
+
+// monthComplete reports whether the month starting at month has ended.
+func monthComplete(month, now time.Time) bool {
+	return !month.AddDate(0, 1, 0).After(now)
+}
+
+func sortedIntradayRows(rows []timeseries.IntradayRow) []timeseries.IntradayRow {
+	rows = slices.Clone(rows)
+	slices.SortFunc(rows, func(a, b timeseries.IntradayRow) int { return a.TimeStamp.Compare(b.TimeStamp) })
+	return rows
+}
@@ -11,16 +11,20 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"iter"
 	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"golang.org/x/time/rate"
 
 	"github.com/portfoliotree/alphavantage/api"
+	"github.com/portfoliotree/alphavantage/query/commodities"
+	"github.com/portfoliotree/alphavantage/query/economic"
 )
 
 //go:generate go run ./cmd/av-generate
@@ -59,6 +63,13 @@ type Client struct {
 	APIKey string
 
 	BaseURL url.URL
+
+	// Location is the time zone timestamps without a zone are parsed in.
+	// When nil, intraday timestamps are parsed in the time zone AlphaVantage
+	// reports for the function, US/Eastern for equities and UTC for forex and
+	// crypto, and dates in UTC so series of different markets line up (see
+	// api.FunctionLocation).
+	Location *time.Location
 }
 
 type Waiter interface {
@@ -152,22 +163,138 @@ func (client *Client) Query(ctx context.Context, query QueryEncoder) (*http.Resp
 
 type querier interface {
 	Query(ctx context.Context, query QueryEncoder) (*http.Response, error)
+	location(query url.Values) (*time.Location, error)
+}
+
+// location returns the location timestamps in the response to query are
+// parsed in; see Client.Location.
+func (client *Client) location(query url.Values) (*time.Location, error) {
+	if client.Location != nil {
+		return client.Location, nil
+	}
+	return api.FunctionLocation(query.Get("function"), query.Get("interval"))
+}
+
+func queryLocation(client querier, query QueryEncoder) (url.Values, *time.Location, error) {
+	values, err := url.ParseQuery(query.Encode())
+	if err != nil {
+		return nil, nil, err
+	}
+	location, err := client.location(values)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load time zone: %w", err)
+	}
+	return values, location, nil
 }
 
 func queryRows[R any](ctx context.Context, client querier, query QueryEncoder) ([]R, error) {
+	_, location, err := queryLocation(client, query)
+	if err != nil {
+		return nil, err
+	}
+	return queryRowsIn[R](ctx, client, query, location)
+}
+
+func queryRowsIn[R any](ctx context.Context, client querier, query QueryEncoder, location *time.Location) ([]R, error) {
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 	defer closeAndIgnoreError(res.Body)
 	var rows []R
-	err = api.ParseCSV(res.Body, &rows, time.UTC)
+	err = api.ParseCSV(res.Body, &rows, location)
 	if err != nil {
 		return nil, err
 	}
 	return rows, nil
 }
 
+// queryRowsSeq is like queryRows but sends the request when the iteration
+// starts and parses the rows as they are read from the response body.
+func queryRowsSeq[R any](ctx context.Context, client querier, query QueryEncoder) iter.Seq2[R, error] {
+	return func(yield func(R, error) bool) {
+		_, location, err := queryLocation(client, query)
+		if err != nil {
+			var zero R
+			yield(zero, err)
+			return
+		}
+		res, err := client.Query(ctx, query)
+		if err != nil {
+			var zero R
+			yield(zero, err)
+			return
+		}
+		for row, err := range api.Rows[R](res.Body, location) {
+			if !yield(row, err) {
+				return
+			}
+		}
+	}
+}
+
+// querySeries is like queryRows but also returns metadata derived from the
+// query, as CSV responses do not include the "Meta Data" of JSON responses.
+func querySeries[R any](ctx context.Context, client querier, query QueryEncoder) (api.Series[R], error) {
+	values, location, err := queryLocation(client, query)
+	if err != nil {
+		return api.Series[R]{}, err
+	}
+	rows, err := queryRowsIn[R](ctx, client, query, location)
+	if err != nil {
+		return api.Series[R]{}, err
+	}
+	return api.NewSeries(seriesMeta(values), rows), nil
+}
+
+// seriesMeta derives the metadata of the response to query. LastRefreshed
+// is left for api.NewSeries to set from the rows.
+func seriesMeta(query url.Values) api.Meta {
+	meta := api.Meta{
+		Symbol:     query.Get("symbol"),
+		Interval:   cmp.Or(query.Get("interval"), functionInterval(query.Get("function"))),
+		OutputSize: query.Get("outputsize"),
+		TimeZone:   functionTimeZone(query.Get("function")),
+		Fields:     make(map[string]string, len(query)),
+	}
+	for key := range query {
+		if key != "apikey" && key != "datatype" {
+			meta.Fields[key] = query.Get(key)
+		}
+	}
+	return meta
+}
+
+// functionInterval returns the interval named by functions like
+// TIME_SERIES_WEEKLY_ADJUSTED or FX_DAILY.
+func functionInterval(function string) string {
+	for _, interval := range []string{"DAILY", "WEEKLY", "MONTHLY"} {
+		if strings.HasSuffix(function, "_"+interval) || strings.Contains(function, "_"+interval+"_") {
+			return strings.ToLower(interval)
+		}
+	}
+	return ""
+}
+
+// functionTimeZone returns the time zone AlphaVantage reports in the JSON
+// metadata of function, whatever location the rows are parsed in: UTC for
+// forex and crypto, none for economic indicators and commodities and
+// US/Eastern otherwise.
+func functionTimeZone(function string) string {
+	for _, prefix := range []string{"FX_", "CRYPTO_", "DIGITAL_CURRENCY_"} {
+		if strings.HasPrefix(function, prefix) {
+			return "UTC"
+		}
+	}
+	if _, ok := economic.Indicator(function).Metadata(); ok {
+		return ""
+	}
+	if _, ok := commodities.Commodity(function).Metadata(); ok {
+		return ""
+	}
+	return "US/Eastern"
+}
+
 func closeAndIgnoreError(c io.Closer) {
 	_ = c.Close()
 }
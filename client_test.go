@@ -6,9 +6,15 @@ import (
 	_ "embed"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -17,7 +23,12 @@ import (
 
 	"github.com/portfoliotree/alphavantage"
 	"github.com/portfoliotree/alphavantage/api"
+	"github.com/portfoliotree/alphavantage/query/commodities"
+	"github.com/portfoliotree/alphavantage/query/economic"
+	"github.com/portfoliotree/alphavantage/query/forex"
 	"github.com/portfoliotree/alphavantage/query/fundamental"
+	"github.com/portfoliotree/alphavantage/query/intelligence"
+	"github.com/portfoliotree/alphavantage/query/technical"
 	"github.com/portfoliotree/alphavantage/query/timeseries"
 )
 
@@ -102,11 +113,12 @@ func TestClient_ETFProfile(t *testing.T) {
 	profile, err := client.ETFProfile(t.Context(), query)
 	require.NoError(t, err)
 
-	assert.Equal(t, "654800000000", profile.NetAssets)
-	assert.Equal(t, "0.000945", profile.NetExpenseRatio)
-	assert.Equal(t, "0.03", profile.PortfolioTurnover)
-	assert.Equal(t, "0.0108", profile.DividendYield)
-	assert.Equal(t, "1993-01-22", profile.InceptionDate)
+	assert.Equal(t, "SPY", profile.Symbol)
+	assert.Equal(t, 654800000000.0, profile.NetAssets)
+	assert.Equal(t, 0.000945, profile.NetExpenseRatio)
+	assert.Equal(t, 0.03, profile.PortfolioTurnover)
+	assert.Equal(t, 0.0108, profile.DividendYield)
+	assert.Equal(t, mustParseDate(t, "1993-01-22"), profile.InceptionDate)
 	assert.Equal(t, "NO", profile.Leveraged)
 	assert.NotEmpty(t, profile.Sectors)
 	assert.NotEmpty(t, profile.Holdings)
@@ -114,17 +126,56 @@ func TestClient_ETFProfile(t *testing.T) {
 	// Check first sector
 	if assert.True(t, len(profile.Sectors) > 0, "no sectors found") {
 		assert.Equal(t, "INFORMATION TECHNOLOGY", profile.Sectors[0].Sector)
-		assert.Equal(t, "0.337", profile.Sectors[0].Weight)
+		assert.Equal(t, 0.337, profile.Sectors[0].Weight)
 	}
 
 	// Check first holding
 	if assert.True(t, len(profile.Holdings) > 0, "no holdings found") {
 		assert.Equal(t, "NVDA", profile.Holdings[0].Symbol)
 		assert.Equal(t, "NVIDIA CORP", profile.Holdings[0].Description)
-		assert.Equal(t, "0.076", profile.Holdings[0].Weight)
+		assert.Equal(t, 0.076, profile.Holdings[0].Weight)
 	}
 }
 
+func TestClient_ETFLookThrough(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "ETF_PROFILE", req.URL.Query().Get("function"))
+		http.ServeFile(res, req, "testdata/"+req.URL.Query().Get("symbol")+"_etf_profile.json")
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+
+	client := alphavantage.NewClient()
+	client.Limiter = nil
+
+	exposure, err := client.ETFLookThrough(t.Context(), map[string]float64{
+		"SPY": 0.6,
+		"VTI": 0.4,
+	})
+	require.NoError(t, err)
+
+	if assert.NotEmpty(t, exposure.Sectors) {
+		assert.Equal(t, "INFORMATION TECHNOLOGY", exposure.Sectors[0].Sector)
+		assert.InDelta(t, 0.6*0.337+0.4*0.28, exposure.Sectors[0].Weight, 1e-9)
+	}
+
+	if assert.NotEmpty(t, exposure.Holdings) {
+		assert.Equal(t, "NVDA", exposure.Holdings[0].Symbol)
+		assert.Equal(t, "NVIDIA CORP", exposure.Holdings[0].Description)
+	}
+
+	total := exposure.Unlisted
+	for _, h := range exposure.Holdings {
+		total += h.Weight
+	}
+	assert.InDelta(t, 1.0, total, 0.01)
+
+	t.Run("missing profile", func(t *testing.T) {
+		_, err := fundamental.LookThrough(nil, map[string]float64{"SPY": 1})
+		assert.ErrorContains(t, err, "SPY")
+	})
+}
+
 // StockPrice represents a daily stock price with struct tags for CSV parsing.
 // Supported field types: string, int, float64, time.Time
 type StockPrice struct {
@@ -373,4 +424,747 @@ func TestClient_GlobalQuote(t *testing.T) {
 
 	require.Len(t, data, 1)
 	assert.Equal(t, data[0].Symbol, "IBM")
+	assert.Equal(t, 300.0, data[0].Open)
+	assert.Equal(t, 305.69, data[0].Price)
+	assert.Equal(t, 3592455, data[0].Volume)
+	assert.Equal(t, mustParseDate(t, "2025-11-14"), data[0].LatestDay)
+	assert.Equal(t, 0.83, data[0].Change)
+	assert.Equal(t, api.Percent(0.2723), data[0].ChangePercent)
+}
+
+func TestTimeSeriesFunctions_Quotes(t *testing.T) {
+	t.Run("bulk", func(t *testing.T) {
+		var requestedSymbols []string
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "REALTIME_BULK_QUOTES", req.URL.Query().Get("function"))
+			assert.Equal(t, "csv", req.URL.Query().Get("datatype"))
+			symbols := strings.Split(req.URL.Query().Get("symbol"), ",")
+			assert.LessOrEqual(t, len(symbols), timeseries.RealtimeBulkQuotesMaxSymbols)
+			requestedSymbols = append(requestedSymbols, symbols...)
+			_, _ = io.WriteString(res, "symbol,timestamp,open,high,low,close,volume,previous_close,change,change_percent,extended_hours_quote,extended_hours_change,extended_hours_change_percent\n")
+			for _, symbol := range symbols {
+				_, _ = fmt.Fprintf(res, "%s,2024-10-18 19:59:55.291,417.61,419.649,416.2601,418.16,17145307,416.72,1.44,0.3456,418.1,-0.06,-0.01435\n", symbol)
+			}
+		}))
+		t.Cleanup(server.Close)
+		t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+
+		client := alphavantage.NewClient()
+		client.Limiter = nil
+
+		symbols := make([]string, 150)
+		for i := range symbols {
+			symbols[i] = fmt.Sprintf("S%03d", i)
+		}
+		rows, err := client.TimeSeries().Quotes(t.Context(), symbols...)
+		require.NoError(t, err)
+		require.Len(t, rows, len(symbols))
+		assert.Equal(t, symbols, requestedSymbols)
+		assert.Equal(t, "S000", rows[0].Symbol)
+		assert.Equal(t, time.Date(2024, 10, 18, 19, 59, 55, 291e6, time.UTC), rows[0].TimeStamp)
+		assert.Equal(t, 418.16, rows[0].Close)
+		assert.Equal(t, api.Percent(0.3456), rows[0].ChangePercent)
+		assert.Equal(t, api.Percent(-0.01435), rows[0].ExtendedHoursChangePercent)
+	})
+
+	t.Run("premium fallback", func(t *testing.T) {
+		var functions []string
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			fn := req.URL.Query().Get("function")
+			functions = append(functions, fn)
+			switch fn {
+			case "REALTIME_BULK_QUOTES":
+				http.ServeFile(res, req, "specification/testdata/examples/time_series/REALTIME_BULK_QUOTES_505ed5cc.json")
+			case "GLOBAL_QUOTE":
+				http.ServeFile(res, req, "testdata/global_quote_IBM.csv")
+			default:
+				t.Errorf("unexpected function %s", fn)
+			}
+		}))
+		t.Cleanup(server.Close)
+		t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+
+		client := alphavantage.NewClient()
+		client.Limiter = nil
+
+		rows, err := client.TimeSeries().Quotes(t.Context(), "IBM", "IBM")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"REALTIME_BULK_QUOTES", "GLOBAL_QUOTE", "GLOBAL_QUOTE"}, functions)
+		require.Len(t, rows, 2)
+		assert.Equal(t, "IBM", rows[0].Symbol)
+		assert.Equal(t, 305.69, rows[0].Close)
+		assert.Equal(t, mustParseDate(t, "2025-11-14"), rows[0].TimeStamp)
+	})
+}
+
+func TestTechnicalRows(t *testing.T) {
+	t.Run("daily", func(t *testing.T) {
+		f, err := os.Open(filepath.FromSlash("specification/testdata/examples/technical_macd_stoch/MACD_19e73cc7.csv"))
+		require.NoError(t, err)
+		var rows []technical.MovingAverageConvergenceDivergenceRow
+		require.NoError(t, api.ParseCSV(f, &rows, nil))
+		require.NotEmpty(t, rows)
+		assert.Equal(t, mustParseDate(t, "2026-05-15"), rows[0].Time)
+		assert.Equal(t, -6.0102, rows[0].MACD)
+		assert.Equal(t, -0.9742, rows[0].MACDHist)
+		assert.Equal(t, -5.0361, rows[0].MACDSignal)
+	})
+
+	t.Run("intraday", func(t *testing.T) {
+		f, err := os.Open(filepath.FromSlash("specification/testdata/examples/technical_special_ma/VWAP_2cb5b135.csv"))
+		require.NoError(t, err)
+		var rows []technical.VolumeWeightedAveragePriceRow
+		require.NoError(t, api.ParseCSV(f, &rows, nil))
+		require.NotEmpty(t, rows)
+		assert.Equal(t, time.Date(2026, 5, 15, 19, 45, 0, 0, time.UTC), rows[0].Time)
+		assert.Equal(t, 219.1185, rows[0].Value)
+	})
+}
+
+func TestTimeSeriesFunctions_AdjustedDaily(t *testing.T) {
+	examples := "specification/testdata/examples"
+	var functions []string
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		fn := req.URL.Query().Get("function")
+		functions = append(functions, fn)
+		assert.Equal(t, "IBM", req.URL.Query().Get("symbol"))
+		switch fn {
+		case "TIME_SERIES_DAILY":
+			http.ServeFile(res, req, filepath.Join(examples, "time_series/TIME_SERIES_DAILY_42a08190.csv"))
+		case "SPLITS":
+			http.ServeFile(res, req, filepath.Join(examples, "fundamental/SPLITS_358e2618.csv"))
+		case "DIVIDENDS":
+			http.ServeFile(res, req, filepath.Join(examples, "fundamental/DIVIDENDS_5b9f5d9f.csv"))
+		default:
+			t.Errorf("unexpected function %s", fn)
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+
+	client := alphavantage.NewClient()
+	client.Limiter = nil
+
+	adjusted, err := client.TimeSeries().AdjustedDaily(t.Context(), timeseries.QueryDaily(client.APIKey, "IBM").DataTypeCSV())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TIME_SERIES_DAILY", "SPLITS", "DIVIDENDS"}, functions)
+
+	f, err := os.Open(filepath.Join(examples, "time_series/TIME_SERIES_DAILY_ADJUSTED_572d0539.csv"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+	var want []timeseries.DailyAdjustedRow
+	require.NoError(t, api.ParseCSV(f, &want, time.UTC))
+	require.Len(t, adjusted.Rows, len(want))
+	for i, row := range adjusted.Rows {
+		assert.Equal(t, want[i].TimeStamp, row.TimeStamp)
+		assert.InDelta(t, want[i].AdjustedClose, row.Close, 1e-9, "adjusted close on %s", row.TimeStamp.Format(time.DateOnly))
+		assert.InDelta(t, want[i].DividendAmount, row.DividendAmount, 1e-9)
+		assert.Equal(t, float64(want[i].Volume), row.Volume)
+	}
+	oldest := adjusted.Rows[len(adjusted.Rows)-1]
+	assert.Equal(t, 1.0, oldest.TotalReturn)
+	assert.InDelta(t, adjusted.Rows[0].Close/oldest.Close, adjusted.Rows[0].TotalReturn, 1e-12)
+
+	var applied []string
+	for _, action := range adjusted.Actions {
+		if action.Applied {
+			applied = append(applied, action.Date.Format(time.DateOnly))
+		}
+	}
+	assert.Equal(t, []string{"2026-05-08", "2026-02-10"}, applied)
+	assert.Equal(t, time.Date(2026, 5, 8, 0, 0, 0, 0, time.UTC), adjusted.Actions[0].Date)
+	assert.InDelta(t, 229.76/(229.76+1.69), adjusted.Actions[0].PriceFactor, 1e-12)
+}
+
+func TestAdjustDaily(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 6, d, 0, 0, 0, 0, time.UTC) }
+	daily := []timeseries.DailyRow{
+		{TimeStamp: day(11), Open: 51, High: 52, Low: 50, Close: 51, Volume: 2000},
+		{TimeStamp: day(10), Open: 50, High: 51, Low: 49, Close: 50, Volume: 2000},
+		{TimeStamp: day(7), Open: 100, High: 102, Low: 98, Close: 100, Volume: 1000},
+	}
+	splits := []fundamental.SplitsRow{
+		// effective on a weekend, so it applies from the next bar
+		{EffectiveDate: day(8), SplitFactor: 2},
+		{EffectiveDate: day(1), SplitFactor: 3},
+	}
+	dividends := []fundamental.DividendsRow{
+		{ExDividendDate: day(11), Amount: 1},
+		{ExDividendDate: day(20), Amount: 1},
+	}
+	adjusted := alphavantage.AdjustDaily(daily, splits, dividends)
+
+	dividendFactor := 51.0 / 52
+	assert.Equal(t, []alphavantage.AdjustedDailyRow{
+		{TimeStamp: day(11), Open: 51, High: 52, Low: 50, Close: 51, Volume: 2000, TotalReturn: 51 / (50 * dividendFactor), DividendAmount: 1, SplitCoefficient: 1},
+		{TimeStamp: day(10), Open: 50 * dividendFactor, High: 51 * dividendFactor, Low: 49 * dividendFactor, Close: 50 * dividendFactor, Volume: 2000, TotalReturn: 1, SplitCoefficient: 2},
+		{TimeStamp: day(7), Open: 50 * dividendFactor, High: 51 * dividendFactor, Low: 49 * dividendFactor, Close: 50 * dividendFactor, Volume: 2000, TotalReturn: 1, SplitCoefficient: 1},
+	}, adjusted.Rows)
+
+	require.Len(t, adjusted.Actions, 4)
+	assert.False(t, adjusted.Actions[0].Applied, "the dividend after the last bar")
+	assert.True(t, adjusted.Actions[1].Applied)
+	assert.Equal(t, alphavantage.CorporateAction{Date: day(8), Split: 2, PriceFactor: 0.5, VolumeFactor: 2, Applied: true}, adjusted.Actions[2])
+	assert.False(t, adjusted.Actions[3].Applied, "the split before the first bar")
+}
+
+type memoryMonthStore map[string][]timeseries.IntradayRow
+
+func (s memoryMonthStore) LoadMonth(symbol, interval string, month time.Time) ([]timeseries.IntradayRow, bool, error) {
+	rows, ok := s[symbol+interval+month.Format("2006-01")]
+	return rows, ok, nil
+}
+
+func (s memoryMonthStore) SaveMonth(symbol, interval string, month time.Time, rows []timeseries.IntradayRow) error {
+	s[symbol+interval+month.Format("2006-01")] = rows
+	return nil
+}
+
+func TestTimeSeriesFunctions_Backfill(t *testing.T) {
+	bar := func(ts string) string { return ts + ",100.0,101.0,99.0,100.5,1000\n" }
+	responses := map[string]string{
+		"2024-02": bar("2024-02-29 19:30:00") + bar("2024-02-29 16:00:00") + bar("2024-02-01 09:30:00"),
+		// the March response repeats the last February bar
+		"2024-03": bar("2024-03-28 16:00:00") + bar("2024-03-01 09:30:00") + bar("2024-02-29 16:00:00"),
+	}
+	var requested []string
+	failMarch := true
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		q := req.URL.Query()
+		assert.Equal(t, "TIME_SERIES_INTRADAY", q.Get("function"))
+		assert.Equal(t, "IBM", q.Get("symbol"))
+		assert.Equal(t, "1min", q.Get("interval"))
+		assert.Equal(t, "full", q.Get("outputsize"))
+		month := q.Get("month")
+		requested = append(requested, month)
+		if month == "2024-03" && failMarch {
+			failMarch = false
+			http.Error(res, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = io.WriteString(res, "timestamp,open,high,low,close,volume\n"+responses[month])
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+
+	client := alphavantage.NewClient()
+	var waits int
+	client.Limiter = waitFunc(func(context.Context) error {
+		waits++
+		return nil
+	})
+
+	store := memoryMonthStore{
+		"IBM1min2024-01": {
+			{TimeStamp: time.Date(2024, 1, 31, 16, 0, 0, 0, time.UTC), Close: 1},
+			{TimeStamp: time.Date(2024, 1, 2, 9, 30, 0, 0, time.UTC), Close: 1},
+		},
+	}
+	from := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 31, 23, 59, 0, 0, time.UTC)
+	collect := func() ([]string, error) {
+		var times []string
+		for row, err := range client.TimeSeries().Backfill(t.Context(), "IBM", "1min", from, to, store) {
+			if err != nil {
+				return times, err
+			}
+			times = append(times, row.TimeStamp.Format(time.DateTime))
+		}
+		return times, nil
+	}
+
+	times, err := collect()
+	require.ErrorContains(t, err, "2024-03")
+	assert.Equal(t, []string{"2024-01-31 16:00:00", "2024-02-01 09:30:00", "2024-02-29 16:00:00", "2024-02-29 19:30:00"}, times)
+	assert.Equal(t, []string{"2024-02", "2024-03"}, requested)
+	assert.Equal(t, 2, waits)
+	assert.Contains(t, store, "IBM1min2024-02")
+
+	requested = nil
+	times, err = collect()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2024-03"}, requested, "saved months are not requested again")
+	assert.Equal(t, []string{
+		"2024-01-31 16:00:00",
+		"2024-02-01 09:30:00",
+		"2024-02-29 16:00:00",
+		"2024-02-29 19:30:00",
+		"2024-03-01 09:30:00",
+		"2024-03-28 16:00:00",
+	}, times)
+	assert.Contains(t, store, "IBM1min2024-03")
+
+	// 19:30 in New York is the next day in UTC; from and to are wall clocks
+	requested = nil
+	from = time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+	to = time.Date(2024, 2, 29, 23, 59, 0, 0, time.UTC)
+	times, err = collect()
+	require.NoError(t, err)
+	assert.Empty(t, requested)
+	assert.Equal(t, []string{"2024-02-29 16:00:00", "2024-02-29 19:30:00"}, times)
+}
+
+func TestTimeSeriesFunctions_DailySeq(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		requests.Add(1)
+		if req.URL.Query().Get("symbol") != "IBM" {
+			http.Error(res, "unknown symbol", http.StatusNotFound)
+			return
+		}
+		http.ServeFile(res, req, "specification/testdata/examples/time_series/TIME_SERIES_DAILY_42a08190.csv")
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+	client := alphavantage.NewClient()
+	client.Limiter = nil
+
+	rows := client.TimeSeries().DailySeq(t.Context(), timeseries.QueryDaily(client.APIKey, "IBM").DataTypeCSV())
+	assert.Zero(t, requests.Load(), "the request is sent when the iteration starts")
+	count := 0
+	for row, err := range rows {
+		require.NoError(t, err)
+		assert.False(t, row.TimeStamp.IsZero())
+		count++
+	}
+	assert.Equal(t, 100, count)
+
+	count = 0
+	for _, err := range client.TimeSeries().DailySeq(t.Context(), timeseries.QueryDaily(client.APIKey, "IBM").DataTypeCSV()) {
+		require.NoError(t, err)
+		if count++; count == 3 {
+			break
+		}
+	}
+	assert.Equal(t, 3, count)
+
+	for _, err := range client.TimeSeries().DailySeq(t.Context(), timeseries.QueryDaily(client.APIKey, "NOPE").DataTypeCSV()) {
+		assert.ErrorContains(t, err, "404")
+	}
+}
+
+func TestTimeSeriesFunctions_IntradaySeries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		switch req.URL.Query().Get("function") {
+		case "TIME_SERIES_INTRADAY":
+			http.ServeFile(res, req, "specification/testdata/examples/time_series/TIME_SERIES_INTRADAY_3dbdcdc7.csv")
+		case "FX_DAILY":
+			http.ServeFile(res, req, "specification/testdata/examples/forex/FX_DAILY_b7946c44.csv")
+		default:
+			http.ServeFile(res, req, "specification/testdata/examples/time_series/TIME_SERIES_DAILY_42a08190.csv")
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+	client := alphavantage.NewClient()
+	client.Limiter = nil
+	eastern, err := api.Eastern()
+	require.NoError(t, err)
+
+	series, err := client.TimeSeries().IntradaySeries(t.Context(), timeseries.QueryIntraday(client.APIKey, "IBM", "5min").OutputSizeCompact().DataTypeCSV())
+	require.NoError(t, err)
+	require.Len(t, series.Rows, 100)
+	assert.Equal(t, time.Date(2026, 5, 15, 19, 55, 0, 0, eastern), series.Rows[0].TimeStamp, "intraday timestamps are in the exchange time zone")
+	assert.Equal(t, "IBM", series.Meta.Symbol)
+	assert.Equal(t, "5min", series.Meta.Interval)
+	assert.Equal(t, "compact", series.Meta.OutputSize)
+	assert.Equal(t, "US/Eastern", series.Meta.TimeZone, "the time zone JSON responses report")
+	assert.Same(t, eastern, series.Rows[0].TimeStamp.Location(), "one location for the market")
+	assert.Equal(t, series.Rows[0].TimeStamp, series.Meta.LastRefreshed)
+	assert.Equal(t, "TIME_SERIES_INTRADAY", series.Meta.Fields["function"])
+	assert.NotContains(t, series.Meta.Fields, "apikey")
+
+	daily, err := client.TimeSeries().DailySeries(t.Context(), timeseries.QueryDaily(client.APIKey, "IBM").DataTypeCSV())
+	require.NoError(t, err)
+	assert.Equal(t, "daily", daily.Meta.Interval)
+	assert.Equal(t, "US/Eastern", daily.Meta.TimeZone, "the time zone does not depend on the location of the rows")
+	assert.Equal(t, time.UTC, daily.Rows[0].TimeStamp.Location(), "dates are parsed in UTC")
+
+	fx, err := client.Forex().DailySeries(t.Context(), forex.QueryDaily(client.APIKey, "EUR", "USD").DataTypeCSV())
+	require.NoError(t, err)
+	assert.Equal(t, "UTC", fx.Meta.TimeZone)
+
+	client.Location = time.FixedZone("UTC-5", -5*60*60)
+	daily, err = client.TimeSeries().DailySeries(t.Context(), timeseries.QueryDaily(client.APIKey, "IBM").DataTypeCSV())
+	require.NoError(t, err)
+	assert.Equal(t, client.Location, daily.Rows[0].TimeStamp.Location(), "the client location is used for every function")
+	assert.Equal(t, "US/Eastern", daily.Meta.TimeZone)
+}
+
+func TestTimeSeriesFunctions_DailyPanel(t *testing.T) {
+	responses := map[string]string{
+		"AAA": "2024-06-05,1,1,1,1.5,10\n2024-06-04,1,1,1,1.4,10\n2024-06-03,1,1,1,1.3,10\n",
+		"BBB": "2024-06-05,2,2,2,2.5,20\n2024-06-03,2,2,2,2.3,20\n2024-05-31,2,2,2,2.1,20\n",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		q := req.URL.Query()
+		assert.Equal(t, "TIME_SERIES_DAILY", q.Get("function"))
+		assert.Equal(t, "full", q.Get("outputsize"))
+		body, ok := responses[q.Get("symbol")]
+		if !ok {
+			http.Error(res, "unknown symbol", http.StatusNotFound)
+			return
+		}
+		_, _ = io.WriteString(res, "timestamp,open,high,low,close,volume\n"+body)
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+
+	client := alphavantage.NewClient()
+	var waits atomic.Int32
+	client.Limiter = waitFunc(func(context.Context) error {
+		waits.Add(1)
+		return nil
+	})
+	query := timeseries.QueryDaily(client.APIKey, "").OutputSizeFull().DataTypeCSV()
+
+	t.Run("union", func(t *testing.T) {
+		panel, err := client.TimeSeries().DailyPanel(t.Context(), query, alphavantage.Union, "AAA", "BBB")
+		require.NoError(t, err)
+		assert.Equal(t, []time.Time{
+			mustParseDate(t, "2024-05-31"),
+			mustParseDate(t, "2024-06-03"),
+			mustParseDate(t, "2024-06-04"),
+			mustParseDate(t, "2024-06-05"),
+		}, panel.Dates)
+		assert.Equal(t, []string{"AAA", "BBB"}, panel.Symbols)
+		aaa := panel.Column("AAA")
+		require.Len(t, aaa, 4)
+		assert.True(t, math.IsNaN(aaa[0]))
+		assert.Equal(t, []float64{1.3, 1.4, 1.5}, aaa[1:])
+		assert.Nil(t, panel.Column("CCC"))
+
+		filled := panel.ForwardFill()
+		assert.Equal(t, []float64{2.1, 2.3, 2.3, 2.5}, filled.Column("BBB"))
+		assert.True(t, math.IsNaN(filled.Column("AAA")[0]), "leading gaps are not filled")
+		assert.True(t, math.IsNaN(panel.Column("BBB")[2]), "the panel is not modified")
+
+		var buf bytes.Buffer
+		require.NoError(t, panel.WriteCSV(&buf))
+		assert.Equal(t, "timestamp,AAA,BBB\n"+
+			"2024-05-31,,2.1\n"+
+			"2024-06-03,1.3,2.3\n"+
+			"2024-06-04,1.4,\n"+
+			"2024-06-05,1.5,2.5\n", buf.String())
+	})
+
+	t.Run("intersection", func(t *testing.T) {
+		panel, err := client.TimeSeries().DailyPanel(t.Context(), query, alphavantage.Intersection, "AAA", "BBB")
+		require.NoError(t, err)
+		assert.Equal(t, []time.Time{mustParseDate(t, "2024-06-03"), mustParseDate(t, "2024-06-05")}, panel.Dates)
+		assert.Equal(t, [][]float64{{1.3, 1.5}, {2.3, 2.5}}, panel.Columns)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := client.TimeSeries().DailyPanel(t.Context(), query, alphavantage.Union, "AAA", "CCC")
+		assert.ErrorContains(t, err, "CCC")
+	})
+
+	assert.Equal(t, int32(6), waits.Load(), "every request waits on the limiter")
+}
+
+func TestNewPanel(t *testing.T) {
+	type point struct {
+		t time.Time
+		v float64
+	}
+	at := func(h int) time.Time { return time.Date(2024, 6, 3, h, 0, 0, 0, time.UTC) }
+	panel := alphavantage.NewPanel(alphavantage.Union, []string{"a", "b"}, [][]point{
+		{{at(10), 1}, {at(9), 0}},
+		{{at(11), 2}},
+	}, func(p point) time.Time { return p.t }, func(p point) float64 { return p.v })
+
+	var buf bytes.Buffer
+	require.NoError(t, panel.WriteCSV(&buf))
+	assert.Equal(t, "timestamp,a,b\n"+
+		"2024-06-03 09:00:00,0,\n"+
+		"2024-06-03 10:00:00,1,\n"+
+		"2024-06-03 11:00:00,,2\n", buf.String())
+
+	rebased := alphavantage.NewPanel(alphavantage.Union, []string{"a"}, [][]point{
+		{{at(9), 4}, {at(10), 5}},
+	}, func(p point) time.Time { return p.t }, func(p point) float64 { return p.v }).Rebase(100)
+	assert.Equal(t, []float64{100, 125}, rebased.Column("a"))
+	rebased = panel.Rebase(100)
+	assert.True(t, math.IsNaN(rebased.Column("b")[0]))
+	assert.Equal(t, 100.0, rebased.Column("b")[2], "columns are rebased on their first value")
+	assert.Equal(t, 2.0, panel.Column("b")[2], "the panel is not modified")
+}
+
+func TestClient_AnalyticsFixedWindow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		q := req.URL.Query()
+		assert.Equal(t, "ANALYTICS_FIXED_WINDOW", q.Get("function"))
+		assert.Equal(t, "MEAN,STDDEV(annualized=True),AUTOCORRELATION(lag=2)", q.Get("CALCULATIONS"))
+		http.ServeFile(res, req, "specification/testdata/examples/intelligence/ANALYTICS_FIXED_WINDOW_89ad6522.json")
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+	client := alphavantage.NewClient()
+	client.Limiter = nil
+
+	q := intelligence.QueryAnalyticsFixedWindow(client.APIKey, "MSFT,AAPL,IBM", "2023-07-01", "DAILY", "").
+		Calculations(intelligence.Mean, intelligence.StdDev.Annualized(), intelligence.Autocorrelation.Lag(2))
+	result, err := client.AnalyticsFixedWindow(t.Context(), q)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"MSFT", "AAPL", "IBM"}, result.MetaData.Symbols)
+	assert.Equal(t, mustParseDate(t, "2023-07-03"), result.MetaData.MinDate)
+	assert.Equal(t, mustParseDate(t, "2023-08-31"), result.MetaData.MaxDate)
+	assert.Equal(t, "DAILY", result.MetaData.Interval)
+	assert.Equal(t, []intelligence.Calculation{intelligence.Correlation, intelligence.Mean, intelligence.StdDev}, result.Calculations)
+	assert.Equal(t, 0.0025422876074108706, result.Mean["IBM"])
+	assert.Equal(t, 0.014401849168320926, result.StdDev["MSFT"])
+	assert.Nil(t, result.Variance)
+	require.NotNil(t, result.Correlation)
+	c, ok := result.Correlation.At("MSFT", "IBM")
+	require.True(t, ok)
+	assert.Equal(t, -0.0583508133, c)
+	c, ok = result.Correlation.At("IBM", "AAPL")
+	require.True(t, ok)
+	assert.Equal(t, 0.0342698827, c)
+	_, ok = result.Correlation.At("IBM", "TSLA")
+	assert.False(t, ok)
+}
+
+func TestCalculation(t *testing.T) {
+	c := intelligence.StdDev.Annualized()
+	assert.Equal(t, intelligence.Calculation("STDDEV(annualized=True)"), c)
+	assert.Equal(t, intelligence.StdDev, c.Name())
+	assert.True(t, c.IsAnnualized())
+	assert.True(t, intelligence.Calculation("STDDEV(ANNUALIZED=TRUE)").IsAnnualized())
+	assert.False(t, intelligence.StdDev.IsAnnualized())
+
+	lag, ok := intelligence.Autocorrelation.Lag(3).Param("LAG")
+	assert.True(t, ok)
+	assert.Equal(t, "3", lag)
+}
+
+func TestClient_AnalyticsSlidingWindow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		q := req.URL.Query()
+		assert.Equal(t, "ANALYTICS_SLIDING_WINDOW", q.Get("function"))
+		assert.Equal(t, "IBM,AAPL", q.Get("SYMBOLS"))
+		assert.Equal(t, []string{"2026-03-16", "2026-05-15"}, q["RANGE"])
+		assert.Equal(t, "20", q.Get("WINDOW_SIZE"))
+		assert.Equal(t, "MEAN,STDDEV(annualized=True)", q.Get("CALCULATIONS"), "calculations are a set")
+		http.ServeFile(res, req, "specification/testdata/examples/intelligence/ANALYTICS_SLIDING_WINDOW_5a09754f.json")
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+	client := alphavantage.NewClient()
+	client.Limiter = nil
+
+	q := intelligence.QueryAnalyticsSlidingWindow(client.APIKey, "", "", "DAILY", "", "").
+		Symbols("IBM", "AAPL").
+		DateRange(mustParseDate(t, "2026-03-16"), mustParseDate(t, "2026-05-15")).
+		WindowSize(20).
+		Calculations(intelligence.Mean, intelligence.StdDev.Annualized(), intelligence.Mean)
+	result, err := client.AnalyticsSlidingWindow(t.Context(), q)
+	require.NoError(t, err)
+
+	assert.Equal(t, 20, result.MetaData.WindowSize)
+	assert.Equal(t, []string{"IBM", "AAPL"}, result.MetaData.Symbols)
+	assert.Equal(t, []intelligence.Calculation{"MEAN", "STDDEV(ANNUALIZED=TRUE)"}, result.Calculations)
+	require.Len(t, result.Mean["AAPL"], 24)
+	assert.Equal(t, intelligence.RollingValue{
+		Start: mustParseDate(t, "2026-03-16"),
+		End:   mustParseDate(t, "2026-04-14"),
+		Value: 0.0012462337996534234,
+	}, result.Mean["AAPL"][0])
+	require.Len(t, result.StdDev["IBM"], 24)
+	assert.Equal(t, mustParseDate(t, "2026-05-15"), result.StdDev["IBM"][23].End)
+
+	assert.Equal(t, url.Values{"RANGE": {"2month"}}, url.Values(intelligence.AnalyticsSlidingWindowQuery{}.Range(intelligence.LastMonths(2))))
+	assert.Equal(t, url.Values{"RANGE": {"full"}}, url.Values(intelligence.AnalyticsFixedWindowQuery{}.Range(intelligence.RangeFull)))
+}
+
+func TestEconomicFunctions_YieldCurve(t *testing.T) {
+	responses := map[string]string{
+		"3month": "2023-03-03,5.00\n2023-03-02,.\n2023-03-01,4.90\n",
+		"2year":  "2023-03-03,4.80\n2023-03-02,4.85\n2023-03-01,4.70\n",
+		"5year":  "2023-03-03,4.20\n2023-03-02,4.25\n2023-03-01,4.10\n",
+		"7year":  "2023-03-03,4.10\n2023-03-02,4.15\n2023-03-01,4.00\n",
+		"10year": "2023-03-03,4.00\n2023-03-02,4.05\n2023-03-01,4.85\n2023-02-28,3.90\n",
+		"30year": "2023-03-03,4.10\n2023-03-02,4.12\n2023-03-01,4.98\n",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		q := req.URL.Query()
+		assert.Equal(t, "TREASURY_YIELD", q.Get("function"))
+		assert.Equal(t, "daily", q.Get("interval"))
+		_, _ = io.WriteString(res, "timestamp,value\n"+responses[q.Get("maturity")])
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+	client := alphavantage.NewClient()
+	client.Limiter = nil
+
+	curve, err := client.Economic().YieldCurve(t.Context(), "daily")
+	require.NoError(t, err)
+	assert.Equal(t, alphavantage.TreasuryMaturities, curve.Symbols)
+	assert.Equal(t, []float64{0.25, 2, 5, 7, 10, 30}, curve.Tenors)
+	require.Len(t, curve.Dates, 4)
+	assert.Equal(t, mustParseDate(t, "2023-02-28"), curve.Dates[0])
+	assert.True(t, math.IsNaN(curve.Column("3month")[2]), `"." is a missing value`)
+
+	day := mustParseDate(t, "2023-03-03")
+	assert.InDelta(t, 4.8, curve.Yield(day, 2, alphavantage.LinearInterpolation), 1e-12)
+	assert.InDelta(t, 4.05, curve.Yield(day, 8.5, alphavantage.LinearInterpolation), 1e-12)
+	assert.InDelta(t, 4.05, curve.Yield(day, 20, alphavantage.LinearInterpolation), 1e-12)
+	assert.InDelta(t, 4.2, curve.Yield(day, 5, alphavantage.CubicInterpolation), 1e-12, "the spline passes through the maturities")
+	assert.True(t, math.IsNaN(curve.Yield(day, 40, alphavantage.LinearInterpolation)), "no extrapolation")
+	assert.True(t, math.IsNaN(curve.Yield(mustParseDate(t, "2023-03-02"), 0.25, alphavantage.LinearInterpolation)))
+	assert.True(t, math.IsNaN(curve.Yield(mustParseDate(t, "2023-03-04"), 5, alphavantage.LinearInterpolation)))
+
+	spread := curve.Spread2s10s()
+	require.Len(t, spread, 4)
+	assert.True(t, math.IsNaN(spread[0]))
+	assert.InDelta(t, 0.15, spread[1], 1e-12)
+	assert.InDelta(t, -0.8, spread[3], 1e-12)
+	assert.Nil(t, curve.Spread("1month", "10year"))
+
+	inversions := curve.Inversions("3month", "10year")
+	require.Len(t, inversions, 1, "the missing 3 month yield does not end the inversion")
+	assert.Equal(t, mustParseDate(t, "2023-03-01"), inversions[0].Start)
+	assert.Equal(t, mustParseDate(t, "2023-03-03"), inversions[0].End)
+	assert.InDelta(t, -1, inversions[0].Trough, 1e-12)
+	assert.Equal(t, inversions[0].Trough, curve.Spread3m10y()[3])
+	inversions = curve.Inversions("2year", "10year")
+	require.Len(t, inversions, 1)
+	assert.Equal(t, mustParseDate(t, "2023-03-02"), inversions[0].Start)
+	assert.Equal(t, mustParseDate(t, "2023-03-03"), inversions[0].End)
+	assert.InDelta(t, -0.8, inversions[0].Trough, 1e-12)
+}
+
+func TestYieldCurve_cubic(t *testing.T) {
+	row := func(v float64) []economic.TreasuryYieldRow {
+		return []economic.TreasuryYieldRow{{TimeStamp: mustParseDate(t, "2024-01-02"), Value: v}}
+	}
+	curve, err := alphavantage.NewYieldCurve([]string{"2year", "1year", "3year"}, [][]economic.TreasuryYieldRow{row(1), row(0), row(0)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1year", "2year", "3year"}, curve.Symbols, "maturities are sorted by tenor")
+	day := curve.Dates[0]
+	assert.InDelta(t, 0.6875, curve.Yield(day, 1.5, alphavantage.CubicInterpolation), 1e-12)
+	assert.InDelta(t, 0.5, curve.Yield(day, 1.5, alphavantage.LinearInterpolation), 1e-12)
+	assert.Equal(t, []float64{1}, curve.Yields(2, alphavantage.CubicInterpolation))
+
+	_, err = alphavantage.NewYieldCurve([]string{"soon"}, [][]economic.TreasuryYieldRow{row(1)})
+	assert.Error(t, err)
+}
+
+func TestEconomicFunctions_Fetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		q := req.URL.Query()
+		assert.Equal(t, "CPI", q.Get("function"))
+		assert.Equal(t, "monthly", q.Get("interval"))
+		assert.Equal(t, "csv", q.Get("datatype"))
+		http.ServeFile(res, req, "specification/testdata/examples/economic/CPI_453fbf7c.csv")
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+	client := alphavantage.NewClient()
+	client.Limiter = nil
+
+	observations, err := client.Economic().Fetch(t.Context(), economic.CPI, "monthly")
+	require.NoError(t, err)
+	require.Len(t, observations, 1360)
+	assert.Equal(t, economic.Observation{Date: mustParseDate(t, "1913-01-01"), Value: 9.8}, observations[0])
+	last := len(observations) - 1
+	assert.Equal(t, economic.Observation{Date: mustParseDate(t, "2026-04-01"), Value: 333.02}, observations[last])
+	assert.Equal(t, economic.Observation{Date: mustParseDate(t, "2025-10-01"), Missing: true}, observations[last-6])
+
+	yoy := economic.YoY(observations)
+	require.Len(t, yoy, len(observations))
+	assert.True(t, yoy[0].Missing, "there is no observation a year earlier")
+	assert.InDelta(t, (333.02/320.795-1)*100, yoy[last].Value, 1e-9)
+	assert.True(t, yoy[last-6].Missing)
+
+	mom := economic.MoM(observations)
+	assert.True(t, mom[last-5].Missing, "the previous month is missing")
+	assert.InDelta(t, (333.02/330.213-1)*100, mom[last].Value, 1e-9)
+
+	_, err = client.Economic().Fetch(t.Context(), economic.Unemployment, "daily")
+	assert.ErrorContains(t, err, "interval")
+	_, err = client.Economic().Fetch(t.Context(), "GDP", "")
+	assert.ErrorContains(t, err, "unknown")
+}
+
+func TestMetadata_NextRelease(t *testing.T) {
+	cpi, ok := economic.CPI.Metadata()
+	require.True(t, ok)
+	assert.Equal(t, "monthly", cpi.Intervals[0])
+	assert.Equal(t, mustParseDate(t, "2025-11-15"), cpi.NextRelease(mustParseDate(t, "2025-09-01"), ""))
+	gdp, _ := economic.RealGDP.Metadata()
+	assert.Equal(t, mustParseDate(t, "2025-10-30"), gdp.NextRelease(mustParseDate(t, "2025-04-01"), "quarterly"))
+	rate, _ := economic.FederalFundsRate.Metadata()
+	assert.True(t, rate.NextRelease(mustParseDate(t, "2025-09-01"), "daily").IsZero())
+	assert.Len(t, economic.Catalog, 10)
+}
+
+func TestCommoditiesFunctions_Panel(t *testing.T) {
+	var fxRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		q := req.URL.Query()
+		switch q.Get("function") {
+		case "WTI":
+			http.ServeFile(res, req, "specification/testdata/examples/commodities/WTI_6498cf9f.csv")
+		case "ALUMINUM":
+			http.ServeFile(res, req, "specification/testdata/examples/commodities/ALUMINUM_85383733.csv")
+		case "ALL_COMMODITIES":
+			http.ServeFile(res, req, "specification/testdata/examples/commodities/ALL_COMMODITIES_4033e3d9.csv")
+		case "FX_DAILY":
+			fxRequests.Add(1)
+			assert.Equal(t, "USD", q.Get("from_symbol"))
+			assert.Equal(t, "EUR", q.Get("to_symbol"))
+			assert.Equal(t, "full", q.Get("outputsize"))
+			_, _ = io.WriteString(res, "timestamp,open,high,low,close\n2026-03-03,0.9,0.9,0.9,0.92\n2026-03-02,0.9,0.9,0.9,0.90\n2026-02-27,0.9,0.9,0.9,0.85\n")
+		default:
+			t.Errorf("unexpected function %q", q.Get("function"))
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+	client := alphavantage.NewClient()
+	client.Limiter = nil
+
+	aluminum, err := client.Commodities().Fetch(t.Context(), commodities.Aluminum, "monthly")
+	require.NoError(t, err)
+	require.Len(t, aluminum, 555)
+	assert.Equal(t, commodities.Observation{Date: mustParseDate(t, "1980-01-01"), Missing: true}, aluminum[0])
+	assert.True(t, aluminum[slices.IndexFunc(aluminum, func(o commodities.Observation) bool { return o.Date.Equal(mustParseDate(t, "1991-12-01")) })].Missing)
+	_, err = client.Commodities().Fetch(t.Context(), commodities.Brent, "annual")
+	assert.ErrorContains(t, err, "interval")
+
+	panel, err := client.Commodities().Panel(t.Context(), "monthly", "", commodities.WestTexasIntermediate, commodities.Aluminum)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"WTI", "ALUMINUM"}, panel.Symbols)
+	assert.Equal(t, mustParseDate(t, "2026-04-01"), panel.Dates[len(panel.Dates)-1])
+	assert.Equal(t, 100.32, panel.Column("WTI")[len(panel.Dates)-1])
+	assert.True(t, math.IsNaN(panel.Column("ALUMINUM")[len(panel.Dates)-1]))
+	assert.Zero(t, fxRequests.Load())
+
+	panel, err = client.Commodities().Panel(t.Context(), "monthly", "EUR", commodities.WestTexasIntermediate, commodities.Aluminum, commodities.All)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), fxRequests.Load(), "the rates are requested once per currency")
+	march, _ := slices.BinarySearchFunc(panel.Dates, mustParseDate(t, "2026-03-01"), time.Time.Compare)
+	assert.InDelta(t, 91.38*0.91, panel.Column("WTI")[march], 1e-9, "monthly prices use the average rate of the month")
+	assert.InDelta(t, 3372.952727272727*0.91, panel.Column("ALUMINUM")[march], 1e-9)
+	assert.Equal(t, 218.8054299356044, panel.Column("ALL_COMMODITIES")[march], "the index has no currency")
+	assert.True(t, math.IsNaN(panel.Column("WTI")[march+1]), "there are no April rates")
+}
+
+func TestConvertCurrency(t *testing.T) {
+	prices := alphavantage.Panel{
+		Dates:   []time.Time{mustParseDate(t, "2024-01-01"), mustParseDate(t, "2024-01-03"), mustParseDate(t, "2024-01-05")},
+		Symbols: []string{"WTI"},
+		Columns: [][]float64{{10, 20, 30}},
+	}
+	rates := []alphavantage.ExchangeRate{
+		{Date: mustParseDate(t, "2024-01-04"), Rate: 3},
+		{Date: mustParseDate(t, "2024-01-02"), Rate: 2},
+	}
+	converted := alphavantage.ConvertCurrency(prices, "daily", rates)
+	assert.True(t, math.IsNaN(converted.Columns[0][0]))
+	assert.Equal(t, []float64{40, 90}, converted.Columns[0][1:])
+	assert.Equal(t, []float64{10, 20, 30}, prices.Columns[0], "prices are not modified")
 }
@@ -167,6 +167,17 @@ func addTimeForColumnType(functions []specification.Function, imports []string)
 	}
 	return imports
 }
+
+func addAPIForColumnType(functions []specification.Function, imports []string) []string {
+	for _, fn := range functions {
+		for _, col := range fn.CSVColumns {
+			if col.Type == "percent" || col.Type == "ratio" {
+				return append(imports, "github.com/portfoliotree/alphavantage/api")
+			}
+		}
+	}
+	return imports
+}
 func newField(tp ast.Expr, ident ...string) *ast.Field {
 	var names []*ast.Ident
 	for _, name := range ident {
@@ -243,6 +254,7 @@ func generateFile(querierTypes map[string]QuerierType, pkgName, outFileName, bas
 		"net/url",
 	}
 	imports = addTimeForColumnType(functions, imports)
+	imports = addAPIForColumnType(functions, imports)
 
 	for _, fn := range functions {
 		goIdent := goIdentifier(goIdentifiers, pkgName, fn.Name)
@@ -307,8 +319,6 @@ func generateFile(querierTypes map[string]QuerierType, pkgName, outFileName, bas
 		return nil
 	}
 
-	slices.Sort(imports)
-	imports = slices.Compact(imports)
 	for _, im := range imports {
 		importsDecl.Specs = append(importsDecl.Specs, &ast.ImportSpec{Path: stringBasicLiteral(im)})
 	}
@@ -515,34 +525,28 @@ func queryUpdateMethod(queryTypeIdent, methodName, keyName string, params []*ast
 	}
 }
 
-func csvFields(baseFileName string, fn specification.Function, goIdentifiers map[string][]string) *ast.FieldList {
-	if strings.HasPrefix(baseFileName, "technical_") && len(fn.CSVColumns) == 2 {
-		return &ast.FieldList{
-			List: []*ast.Field{
-				{
-					Names: []*ast.Ident{ast.NewIdent(goIdentifiers[fn.CSVColumns[0].Name][0])},
-					Type:  ast.NewIdent("string"),
-					Tag: &ast.BasicLit{
-						Kind:  token.STRING,
-						Value: "`" + fmt.Sprintf(`column-name:%q`, fn.CSVColumns[0].Name) + "`",
-					},
-				},
-				{
-					Names: []*ast.Ident{ast.NewIdent("Value")},
-					Type:  ast.NewIdent("string"),
-					Tag: &ast.BasicLit{
-						Kind:  token.STRING,
-						Value: "`" + fmt.Sprintf(`column-name:%q`, fn.CSVColumns[1].Name) + "`",
-					},
-				},
-			},
-		}
+// columnTags returns the struct tags WriteCSV needs to reproduce the column.
+func columnTags(col specification.CSVColumn) string {
+	var tags string
+	if col.Decimals != 0 {
+		tags += fmt.Sprintf(` decimals:"%d"`, col.Decimals)
+	}
+	if col.Null != "" {
+		tags += fmt.Sprintf(` null:%q`, col.Null)
 	}
+	return tags
+}
+
+func csvFields(baseFileName string, fn specification.Function, goIdentifiers map[string][]string) *ast.FieldList {
+	// technical indicators with a single output name the value column "Value"
+	singleValue := strings.HasPrefix(baseFileName, "technical_") && len(fn.CSVColumns) == 2
 
 	fields := ast.FieldList{}
-	for _, col := range fn.CSVColumns {
+	for i, col := range fn.CSVColumns {
 		fieldName := col.Name
-		if fieldName == fn.Name {
+		if col.Field != "" {
+			fieldName = col.Field
+		} else if fieldName == fn.Name || (singleValue && i == 1) {
 			fieldName = "Value"
 		} else {
 			ns, ok := goIdentifiers[fieldName]
@@ -557,11 +561,21 @@ func csvFields(baseFileName string, fn specification.Function, goIdentifiers map
 		switch col.Type {
 		case "string", "float64", "int":
 			fieldType = ast.Expr(ast.NewIdent(col.Type))
-			tag = "`" + fmt.Sprintf(`column-name:%q`, col.Name) + "`"
+			tag = "`" + fmt.Sprintf(`column-name:%q`, col.Name) + columnTags(col) + "`"
+		case "percent":
+			fieldType = newSel("api", "Percent")
+			tag = "`" + fmt.Sprintf(`column-name:%q`, col.Name) + columnTags(col) + "`"
+			if col.Format != "" {
+				tag = "`" + fmt.Sprintf(`column-name:%q suffix:%q`, col.Name, col.Format) + columnTags(col) + "`"
+			}
+		case "ratio":
+			fieldType = newSel("api", "Ratio")
+			tag = "`" + fmt.Sprintf(`column-name:%q`, col.Name) + columnTags(col) + "`"
 		case "time":
 			fieldType = newSel("time", "Time")
+			tag = "`" + fmt.Sprintf(`column-name:%q`, col.Name) + columnTags(col) + "`"
 			if col.Format != "" {
-				tag = "`" + fmt.Sprintf(`column-name:%q time-layout:%q`, col.Name, col.Format) + "`"
+				tag = "`" + fmt.Sprintf(`column-name:%q time-layout:%q`, col.Name, col.Format) + columnTags(col) + "`"
 			}
 
 		default:
@@ -821,6 +835,31 @@ func generateHandlerFunction(fn specification.Function, qt QuerierType, goIdenti
 		})
 	}
 
+	if qt.RowType != "" {
+		body = append(body, &ast.DeclStmt{
+			Decl: &ast.GenDecl{
+				Tok: token.VAR,
+				Specs: []ast.Spec{
+					&ast.ValueSpec{
+						Names: []*ast.Ident{ast.NewIdent("jsonl")},
+						Type:  ast.NewIdent("bool"),
+					},
+				},
+			},
+		})
+		body = append(body, &ast.ExprStmt{
+			X: &ast.CallExpr{
+				Fun: newSel("flags", "BoolVar"),
+				Args: []ast.Expr{
+					&ast.UnaryExpr{Op: token.AND, X: ast.NewIdent("jsonl")},
+					stringBasicLiteral("jsonl"),
+					ast.NewIdent("false"),
+					stringBasicLiteral("convert the CSV response to newline-delimited JSON"),
+				},
+			},
+		})
+	}
+
 	body = append(body, &ast.IfStmt{
 		Init: &ast.AssignStmt{
 			Lhs: []ast.Expr{ast.NewIdent("err")},
@@ -1083,6 +1122,40 @@ func generateHandlerFunction(fn specification.Function, qt QuerierType, goIdenti
 		},
 	})
 
+	if qt.RowType != "" {
+		// stream the typed rows, parsed in the time zone of the function
+		var seqQuery ast.Expr = ast.NewIdent("query")
+		if slices.Contains(fn.Required, "datatype") || slices.Contains(fn.Optional, "datatype") {
+			seqQuery = &ast.CallExpr{Fun: newSel("query", "DataTypeCSV")}
+		}
+		body = append(body, &ast.IfStmt{
+			Cond: ast.NewIdent("jsonl"),
+			Body: &ast.BlockStmt{
+				List: []ast.Stmt{
+					&ast.ReturnStmt{
+						Results: []ast.Expr{
+							&ast.CallExpr{
+								Fun: ast.NewIdent("writeJSONL"),
+								Args: []ast.Expr{
+									ast.NewIdent("output"),
+									&ast.CallExpr{
+										Fun: &ast.SelectorExpr{
+											X: &ast.CallExpr{
+												Fun: newSel("client", categoryNames[qt.PackageIdent]),
+											},
+											Sel: ast.NewIdent(goIdentifier(goIdentifiers, qt.PackageIdent, fn.Name) + "Seq"),
+										},
+										Args: []ast.Expr{ast.NewIdent("ctx"), seqQuery},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+
 	body = append(body, &ast.AssignStmt{
 		Lhs: []ast.Expr{ast.NewIdent("res"), ast.NewIdent("err")},
 		Tok: token.DEFINE,
@@ -1316,6 +1389,7 @@ func generateCSVTests(querierTypes map[string]QuerierType, functionFiles map[str
 	slices.Sort(imports)
 	imports = slices.Compact(imports)
 	imports = append(imports,
+		"github.com/stretchr/testify/assert",
 		"github.com/stretchr/testify/require",
 		"github.com/portfoliotree/alphavantage/api")
 
@@ -1357,7 +1431,7 @@ func generateCSVTests(querierTypes map[string]QuerierType, functionFiles map[str
 								},
 							},
 							Body: &ast.BlockStmt{
-								List: []ast.Stmt{
+								List: append([]ast.Stmt{
 									&ast.AssignStmt{
 										Lhs: []ast.Expr{ast.NewIdent("buf"), ast.NewIdent("err")},
 										Tok: token.DEFINE,
@@ -1398,7 +1472,28 @@ func generateCSVTests(querierTypes map[string]QuerierType, functionFiles map[str
 										}},
 									},
 									&ast.ExprStmt{X: requireNoError()},
-								},
+									&ast.DeclStmt{
+										Decl: &ast.GenDecl{
+											Tok: token.VAR,
+											Specs: []ast.Spec{
+												&ast.ValueSpec{
+													Names: []*ast.Ident{ast.NewIdent("out")},
+													Type:  newSel("bytes", "Buffer"),
+												},
+											},
+										},
+									},
+									&ast.ExprStmt{X: &ast.CallExpr{
+										Fun: newSel("require", "NoError"),
+										Args: []ast.Expr{
+											ast.NewIdent("t"),
+											&ast.CallExpr{
+												Fun:  newSel("api", "WriteCSV"),
+												Args: []ast.Expr{&ast.UnaryExpr{Op: token.AND, X: ast.NewIdent("out")}, ast.NewIdent("rows")},
+											},
+										},
+									}},
+								}, roundTripAssertions(entree.ID, qt)...),
 							},
 						},
 					},
@@ -1414,20 +1509,68 @@ func generateCSVTests(querierTypes map[string]QuerierType, functionFiles map[str
 	return formatGo(&file, "api/csv_test.go")
 }
 
-func generateClientHelpers(querierTypes map[string]QuerierType, functionFiles map[string][]specification.Function, goIdentifiers map[string][]string, packages map[string]string) error {
-	// Category names mapped to their exported type names
-	categoryNames := map[string]string{
-		"timeseries":   "TimeSeries",
-		"fundamental":  "Fundamental",
-		"technical":    "Technical",
-		"economic":     "Economic",
-		"forex":        "Forex",
-		"crypto":       "Crypto",
-		"commodities":  "Commodities",
-		"intelligence": "Intelligence",
-		"options":      "Options",
+// inexactExamples lists examples WriteCSV can not reproduce byte for byte.
+var inexactExamples = map[string]string{
+	"TREASURY_YIELD_a4d134a9": `Alpha Vantage writes 4.10 as "4.1" on one row`,
+}
+
+// roundTripAssertions returns statements checking that WriteCSV reproduces
+// buf. For inexact examples it checks that the written rows parse back to
+// the same rows instead.
+func roundTripAssertions(id string, qt QuerierType) []ast.Stmt {
+	if _, ok := inexactExamples[id]; !ok {
+		return []ast.Stmt{&ast.ExprStmt{X: &ast.CallExpr{
+			Fun: newSel("assert", "Equal"),
+			Args: []ast.Expr{
+				ast.NewIdent("t"),
+				&ast.CallExpr{Fun: ast.NewIdent("string"), Args: []ast.Expr{ast.NewIdent("buf")}},
+				&ast.CallExpr{Fun: newSel("out", "String")},
+			},
+		}}}
+	}
+	return []ast.Stmt{
+		&ast.DeclStmt{
+			Decl: &ast.GenDecl{
+				Tok: token.VAR,
+				Specs: []ast.Spec{
+					&ast.ValueSpec{
+						Names: []*ast.Ident{ast.NewIdent("written")},
+						Type:  &ast.ArrayType{Elt: newSel(qt.PackageIdent, qt.RowType)},
+					},
+				},
+			},
+		},
+		&ast.ExprStmt{X: &ast.CallExpr{
+			Fun: newSel("require", "NoError"),
+			Args: []ast.Expr{
+				ast.NewIdent("t"),
+				&ast.CallExpr{
+					Fun:  newSel("api", "ParseCSV"),
+					Args: []ast.Expr{&ast.UnaryExpr{Op: token.AND, X: ast.NewIdent("out")}, &ast.UnaryExpr{Op: token.AND, X: ast.NewIdent("written")}, ast.NewIdent("nil")},
+				},
+			},
+		}},
+		&ast.ExprStmt{X: &ast.CallExpr{
+			Fun:  newSel("assert", "Equal"),
+			Args: []ast.Expr{ast.NewIdent("t"), ast.NewIdent("rows"), ast.NewIdent("written")},
+		}},
 	}
+}
+
+// categoryNames maps query packages to the names of their Client accessors.
+var categoryNames = map[string]string{
+	"timeseries":   "TimeSeries",
+	"fundamental":  "Fundamental",
+	"technical":    "Technical",
+	"economic":     "Economic",
+	"forex":        "Forex",
+	"crypto":       "Crypto",
+	"commodities":  "Commodities",
+	"intelligence": "Intelligence",
+	"options":      "Options",
+}
 
+func generateClientHelpers(querierTypes map[string]QuerierType, functionFiles map[string][]specification.Function, goIdentifiers map[string][]string, packages map[string]string) error {
 	file := &ast.File{Name: ast.NewIdent("alphavantage")}
 
 	importsSet := make(map[string]struct{})
@@ -1578,6 +1721,95 @@ func generateClientHelpers(querierTypes map[string]QuerierType, functionFiles ma
 				},
 				Body: &ast.BlockStmt{List: body},
 			})
+
+			// func (f *TimeSeriesFunctions) GlobalQuoteSeq(ctx context.Context, query timeseries.GlobalQuoteQuery) iter.Seq2[timeseries.GlobalQuoteRow, error]
+			importsSet["iter"] = struct{}{}
+			decls = append(decls, &ast.FuncDecl{
+				Recv: &ast.FieldList{
+					List: []*ast.Field{
+						newField(&ast.StarExpr{X: ast.NewIdent(categoryTypeName)}, "f"),
+					},
+				},
+				Name: ast.NewIdent(methodName + "Seq"),
+				Type: &ast.FuncType{
+					Params: &ast.FieldList{List: params},
+					Results: &ast.FieldList{
+						List: []*ast.Field{
+							{Type: &ast.IndexListExpr{
+								X:       newSel("iter", "Seq2"),
+								Indices: []ast.Expr{newSel(pkgName, qt.RowType), ast.NewIdent("error")},
+							}},
+						},
+					},
+				},
+				Body: &ast.BlockStmt{List: []ast.Stmt{
+					&ast.ReturnStmt{
+						Results: []ast.Expr{
+							&ast.CallExpr{
+								Fun: &ast.IndexExpr{
+									X:     ast.NewIdent("queryRowsSeq"),
+									Index: newSel(pkgName, qt.RowType),
+								},
+								Args: []ast.Expr{
+									ast.NewIdent("ctx"),
+									&ast.CallExpr{
+										Fun: &ast.ParenExpr{
+											X: &ast.StarExpr{X: ast.NewIdent("Client")},
+										},
+										Args: []ast.Expr{ast.NewIdent("f")},
+									},
+									ast.NewIdent("query"),
+								},
+							},
+						},
+					},
+				}},
+			})
+
+			// func (f *TimeSeriesFunctions) GlobalQuoteSeries(ctx context.Context, query timeseries.GlobalQuoteQuery) (api.Series[timeseries.GlobalQuoteRow], error)
+			importsSet["github.com/portfoliotree/alphavantage/api"] = struct{}{}
+			decls = append(decls, &ast.FuncDecl{
+				Recv: &ast.FieldList{
+					List: []*ast.Field{
+						newField(&ast.StarExpr{X: ast.NewIdent(categoryTypeName)}, "f"),
+					},
+				},
+				Name: ast.NewIdent(methodName + "Series"),
+				Type: &ast.FuncType{
+					Params: &ast.FieldList{List: params},
+					Results: &ast.FieldList{
+						List: []*ast.Field{
+							{Type: &ast.IndexExpr{
+								X:     newSel("api", "Series"),
+								Index: newSel(pkgName, qt.RowType),
+							}},
+							{Type: ast.NewIdent("error")},
+						},
+					},
+				},
+				Body: &ast.BlockStmt{List: []ast.Stmt{
+					&ast.ReturnStmt{
+						Results: []ast.Expr{
+							&ast.CallExpr{
+								Fun: &ast.IndexExpr{
+									X:     ast.NewIdent("querySeries"),
+									Index: newSel(pkgName, qt.RowType),
+								},
+								Args: []ast.Expr{
+									ast.NewIdent("ctx"),
+									&ast.CallExpr{
+										Fun: &ast.ParenExpr{
+											X: &ast.StarExpr{X: ast.NewIdent("Client")},
+										},
+										Args: []ast.Expr{ast.NewIdent("f")},
+									},
+									ast.NewIdent("query"),
+								},
+							},
+						},
+					},
+				}},
+			})
 		}
 	}
 
@@ -268,6 +268,8 @@ func handleChaikinADLine(client *alphavantage.Client, args []string, output io.W
 	flags.StringVar(&month, "month", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -289,6 +291,9 @@ func handleChaikinADLine(client *alphavantage.Client, args []string, output io.W
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().ChaikinADLineSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -314,6 +319,8 @@ func handleChaikinADOscillator(client *alphavantage.Client, args []string, outpu
 	flags.IntVar(&slowPeriod, "slowperiod", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -341,6 +348,9 @@ func handleChaikinADOscillator(client *alphavantage.Client, args []string, outpu
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().ChaikinADOscillatorSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -364,6 +374,8 @@ func handleAverageDirectionalMovementIndex(client *alphavantage.Client, args []s
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -388,6 +400,9 @@ func handleAverageDirectionalMovementIndex(client *alphavantage.Client, args []s
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().AverageDirectionalMovementIndexSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -411,6 +426,8 @@ func handleAverageDirectionalMovementIndexRating(client *alphavantage.Client, ar
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -435,6 +452,9 @@ func handleAverageDirectionalMovementIndexRating(client *alphavantage.Client, ar
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().AverageDirectionalMovementIndexRatingSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -452,6 +472,8 @@ func handleAllCommodities(client *alphavantage.Client, args []string, output io.
 	flags.StringVar(&interval, "interval", "", "options: daily, weekly, monthly, quarterly, annual")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -463,6 +485,9 @@ func handleAllCommodities(client *alphavantage.Client, args []string, output io.
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Commodities().AllSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -480,6 +505,8 @@ func handleAluminum(client *alphavantage.Client, args []string, output io.Writer
 	flags.StringVar(&interval, "interval", "", "options: daily, weekly, monthly, quarterly, annual")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -491,6 +518,9 @@ func handleAluminum(client *alphavantage.Client, args []string, output io.Writer
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Commodities().AluminumSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -611,6 +641,8 @@ func handleAbsolutePriceOscillator(client *alphavantage.Client, args []string, o
 	flags.IntVar(&movingAverageType, "matype", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -644,6 +676,9 @@ func handleAbsolutePriceOscillator(client *alphavantage.Client, args []string, o
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().AbsolutePriceOscillatorSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -667,6 +702,8 @@ func handleAroon(client *alphavantage.Client, args []string, output io.Writer) e
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -691,6 +728,9 @@ func handleAroon(client *alphavantage.Client, args []string, output io.Writer) e
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().AroonSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -714,6 +754,8 @@ func handleAroonOsc(client *alphavantage.Client, args []string, output io.Writer
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -738,6 +780,9 @@ func handleAroonOsc(client *alphavantage.Client, args []string, output io.Writer
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().AroonOscSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -761,6 +806,8 @@ func handleAverageTrueRange(client *alphavantage.Client, args []string, output i
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -785,6 +832,9 @@ func handleAverageTrueRange(client *alphavantage.Client, args []string, output i
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().AverageTrueRangeSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -839,6 +889,8 @@ func handleBollingerBands(client *alphavantage.Client, args []string, output io.
 	flags.IntVar(&movingAverageType, "matype", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -875,6 +927,9 @@ func handleBollingerBands(client *alphavantage.Client, args []string, output io.
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().BollingerBandsSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -896,6 +951,8 @@ func handleBalanceOfPower(client *alphavantage.Client, args []string, output io.
 	flags.StringVar(&month, "month", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -917,6 +974,9 @@ func handleBalanceOfPower(client *alphavantage.Client, args []string, output io.
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().BalanceOfPowerSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -934,6 +994,8 @@ func handleBrent(client *alphavantage.Client, args []string, output io.Writer) e
 	flags.StringVar(&interval, "interval", "", "options: daily, weekly, monthly")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -945,6 +1007,9 @@ func handleBrent(client *alphavantage.Client, args []string, output io.Writer) e
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Commodities().BrentSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -991,6 +1056,8 @@ func handleCommodityChannelIndex(client *alphavantage.Client, args []string, out
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1015,6 +1082,9 @@ func handleCommodityChannelIndex(client *alphavantage.Client, args []string, out
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().CommodityChannelIndexSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1040,6 +1110,8 @@ func handleChandeMomentumOscillator(client *alphavantage.Client, args []string,
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1067,6 +1139,9 @@ func handleChandeMomentumOscillator(client *alphavantage.Client, args []string,
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().ChandeMomentumOscillatorSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1084,6 +1159,8 @@ func handleCoffee(client *alphavantage.Client, args []string, output io.Writer)
 	flags.StringVar(&interval, "interval", "", "options: daily, weekly, monthly")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1095,6 +1172,9 @@ func handleCoffee(client *alphavantage.Client, args []string, output io.Writer)
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Commodities().CoffeeSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1112,6 +1192,8 @@ func handleCopper(client *alphavantage.Client, args []string, output io.Writer)
 	flags.StringVar(&interval, "interval", "", "options: daily, weekly, monthly")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1123,6 +1205,9 @@ func handleCopper(client *alphavantage.Client, args []string, output io.Writer)
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Commodities().CopperSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1140,6 +1225,8 @@ func handleCorn(client *alphavantage.Client, args []string, output io.Writer) er
 	flags.StringVar(&interval, "interval", "", "options: daily, weekly, monthly")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1151,6 +1238,9 @@ func handleCorn(client *alphavantage.Client, args []string, output io.Writer) er
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Commodities().CornSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1168,6 +1258,8 @@ func handleCotton(client *alphavantage.Client, args []string, output io.Writer)
 	flags.StringVar(&interval, "interval", "", "options: daily, weekly, monthly")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1179,6 +1271,9 @@ func handleCotton(client *alphavantage.Client, args []string, output io.Writer)
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Commodities().CottonSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1196,6 +1291,8 @@ func handleConsumerPriceIndex(client *alphavantage.Client, args []string, output
 	flags.StringVar(&interval, "interval", "", "options: monthly, semiannual")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1207,6 +1304,9 @@ func handleConsumerPriceIndex(client *alphavantage.Client, args []string, output
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Economic().ConsumerPriceIndexSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1230,6 +1330,8 @@ func handleCryptoIntraday(client *alphavantage.Client, args []string, output io.
 	flags.StringVar(&outputSize, "outputsize", "", "options: compact, full")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1250,6 +1352,9 @@ func handleCryptoIntraday(client *alphavantage.Client, args []string, output io.
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Crypto().IntradaySeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1303,6 +1408,8 @@ func handleDoubleExponentialMovingAverage(client *alphavantage.Client, args []st
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1330,6 +1437,9 @@ func handleDoubleExponentialMovingAverage(client *alphavantage.Client, args []st
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().DoubleExponentialMovingAverageSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1347,6 +1457,8 @@ func handleDigitalCurrencyDaily(client *alphavantage.Client, args []string, outp
 	flags.StringVar(&symbol, "symbol", "", "[REQUIRED]")
 	var market string
 	flags.StringVar(&market, "market", "", "[REQUIRED]")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1358,6 +1470,9 @@ func handleDigitalCurrencyDaily(client *alphavantage.Client, args []string, outp
 	}
 	query := crypto.QueryDigitalCurrencyDaily(client.APIKey, symbol, market)
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Crypto().DigitalCurrencyDailySeq(ctx, query))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1375,6 +1490,8 @@ func handleDigitalCurrencyMonthly(client *alphavantage.Client, args []string, ou
 	flags.StringVar(&symbol, "symbol", "", "[REQUIRED]")
 	var market string
 	flags.StringVar(&market, "market", "", "[REQUIRED]")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1386,6 +1503,9 @@ func handleDigitalCurrencyMonthly(client *alphavantage.Client, args []string, ou
 	}
 	query := crypto.QueryDigitalCurrencyMonthly(client.APIKey, symbol, market)
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Crypto().DigitalCurrencyMonthlySeq(ctx, query))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1403,6 +1523,8 @@ func handleDigitalCurrencyWeekly(client *alphavantage.Client, args []string, out
 	flags.StringVar(&symbol, "symbol", "", "[REQUIRED]")
 	var market string
 	flags.StringVar(&market, "market", "", "[REQUIRED]")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1414,6 +1536,9 @@ func handleDigitalCurrencyWeekly(client *alphavantage.Client, args []string, out
 	}
 	query := crypto.QueryDigitalCurrencyWeekly(client.APIKey, symbol, market)
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Crypto().DigitalCurrencyWeeklySeq(ctx, query))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1431,6 +1556,8 @@ func handleDividends(client *alphavantage.Client, args []string, output io.Write
 	flags.StringVar(&symbol, "symbol", "", "[REQUIRED]")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1442,6 +1569,9 @@ func handleDividends(client *alphavantage.Client, args []string, output io.Write
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Fundamental().DividendsSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1457,6 +1587,8 @@ func handleDurables(client *alphavantage.Client, args []string, output io.Writer
 	flags := pflag.NewFlagSet("DURABLES", pflag.ContinueOnError)
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1465,6 +1597,9 @@ func handleDurables(client *alphavantage.Client, args []string, output io.Writer
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Economic().DurablesSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1488,6 +1623,8 @@ func handleDirectionalMovementIndex(client *alphavantage.Client, args []string,
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1512,6 +1649,9 @@ func handleDirectionalMovementIndex(client *alphavantage.Client, args []string,
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().DirectionalMovementIndexSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1552,6 +1692,8 @@ func handleEarningsCalendar(client *alphavantage.Client, args []string, output i
 	flags.StringVar(&symbol, "symbol", "", "")
 	var horizon string
 	flags.StringVar(&horizon, "horizon", "", "options: 3month, 6month, 12month")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1563,6 +1705,9 @@ func handleEarningsCalendar(client *alphavantage.Client, args []string, output i
 		query = query.Horizon(horizon)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Fundamental().EarningsCalendarSeq(ctx, query))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1639,6 +1784,8 @@ func handleExponentialMovingAverage(client *alphavantage.Client, args []string,
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1666,6 +1813,9 @@ func handleExponentialMovingAverage(client *alphavantage.Client, args []string,
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().ExponentialMovingAverageSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1706,6 +1856,8 @@ func handleFederalFundsRate(client *alphavantage.Client, args []string, output i
 	flags.StringVar(&interval, "interval", "", "options: daily, weekly, monthly")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1717,6 +1869,9 @@ func handleFederalFundsRate(client *alphavantage.Client, args []string, output i
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Economic().FederalFundsRateSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1738,6 +1893,8 @@ func handleFXDaily(client *alphavantage.Client, args []string, output io.Writer)
 	flags.StringVar(&outputSize, "outputsize", "", "options: compact, full")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1755,6 +1912,9 @@ func handleFXDaily(client *alphavantage.Client, args []string, output io.Writer)
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Forex().DailySeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1778,6 +1938,8 @@ func handleFXIntraday(client *alphavantage.Client, args []string, output io.Writ
 	flags.StringVar(&outputSize, "outputsize", "", "options: compact, full")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1798,6 +1960,9 @@ func handleFXIntraday(client *alphavantage.Client, args []string, output io.Writ
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Forex().IntradaySeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1817,6 +1982,8 @@ func handleFXMonthly(client *alphavantage.Client, args []string, output io.Write
 	flags.StringVar(&toSymbol, "to-symbol", "", "[REQUIRED]")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1831,6 +1998,9 @@ func handleFXMonthly(client *alphavantage.Client, args []string, output io.Write
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Forex().MonthlySeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1850,6 +2020,8 @@ func handleFXWeekly(client *alphavantage.Client, args []string, output io.Writer
 	flags.StringVar(&toSymbol, "to-symbol", "", "[REQUIRED]")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1864,6 +2036,9 @@ func handleFXWeekly(client *alphavantage.Client, args []string, output io.Writer
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Forex().WeeklySeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1881,6 +2056,8 @@ func handleGlobalQuote(client *alphavantage.Client, args []string, output io.Wri
 	flags.StringVar(&symbol, "symbol", "", "[REQUIRED]")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1892,6 +2069,9 @@ func handleGlobalQuote(client *alphavantage.Client, args []string, output io.Wri
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.TimeSeries().GlobalQuoteSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1911,6 +2091,8 @@ func handleHistoricalOptions(client *alphavantage.Client, args []string, output
 	flags.StringVar(&date, "date", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1925,6 +2107,9 @@ func handleHistoricalOptions(client *alphavantage.Client, args []string, output
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Options().HistoricalSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1948,6 +2133,8 @@ func handleHilbertTransformDCPeriod(client *alphavantage.Client, args []string,
 	flags.StringVar(&month, "month", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -1972,6 +2159,9 @@ func handleHilbertTransformDCPeriod(client *alphavantage.Client, args []string,
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().HilbertTransformDCPeriodSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -1995,6 +2185,8 @@ func handleHilbertTransformDCPhase(client *alphavantage.Client, args []string, o
 	flags.StringVar(&month, "month", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2019,6 +2211,9 @@ func handleHilbertTransformDCPhase(client *alphavantage.Client, args []string, o
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().HilbertTransformDCPhaseSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2042,6 +2237,8 @@ func handleHilbertTransformPhasor(client *alphavantage.Client, args []string, ou
 	flags.StringVar(&month, "month", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2066,6 +2263,9 @@ func handleHilbertTransformPhasor(client *alphavantage.Client, args []string, ou
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().HilbertTransformPhasorSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2089,6 +2289,8 @@ func handleHilbertTransformSine(client *alphavantage.Client, args []string, outp
 	flags.StringVar(&month, "month", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2113,6 +2315,9 @@ func handleHilbertTransformSine(client *alphavantage.Client, args []string, outp
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().HilbertTransformSineSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2136,6 +2341,8 @@ func handleHilbertTransformTrendLine(client *alphavantage.Client, args []string,
 	flags.StringVar(&month, "month", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2160,6 +2367,9 @@ func handleHilbertTransformTrendLine(client *alphavantage.Client, args []string,
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().HilbertTransformTrendLineSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2183,6 +2393,8 @@ func handleHilbertTransformTrendMode(client *alphavantage.Client, args []string,
 	flags.StringVar(&month, "month", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2207,6 +2419,9 @@ func handleHilbertTransformTrendMode(client *alphavantage.Client, args []string,
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().HilbertTransformTrendModeSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2245,6 +2460,8 @@ func handleInflation(client *alphavantage.Client, args []string, output io.Write
 	flags := pflag.NewFlagSet("INFLATION", pflag.ContinueOnError)
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2253,6 +2470,9 @@ func handleInflation(client *alphavantage.Client, args []string, output io.Write
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Economic().InflationSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2289,11 +2509,16 @@ func handleInsiderTransactions(client *alphavantage.Client, args []string, outpu
 
 func handleIPOCalendar(client *alphavantage.Client, args []string, output io.Writer) error {
 	flags := pflag.NewFlagSet("IPO_CALENDAR", pflag.ContinueOnError)
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
 	query := fundamental.QueryIPOCalendar(client.APIKey)
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Fundamental().IPOCalendarSeq(ctx, query))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2319,6 +2544,8 @@ func handleKaufmanAdaptiveMovingAverage(client *alphavantage.Client, args []stri
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2346,6 +2573,9 @@ func handleKaufmanAdaptiveMovingAverage(client *alphavantage.Client, args []stri
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().KaufmanAdaptiveMovingAverageSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2363,6 +2593,8 @@ func handleListingStatus(client *alphavantage.Client, args []string, output io.W
 	flags.StringVar(&date, "date", "", "")
 	var state string
 	flags.StringVar(&state, "state", "", "options: active, delisted")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2374,6 +2606,9 @@ func handleListingStatus(client *alphavantage.Client, args []string, output io.W
 		query = query.State(state)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Fundamental().ListingStatusSeq(ctx, query))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2403,6 +2638,8 @@ func handleMovingAverageConvergenceDivergence(client *alphavantage.Client, args
 	flags.IntVar(&signalPeriod, "signalperiod", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2436,6 +2673,9 @@ func handleMovingAverageConvergenceDivergence(client *alphavantage.Client, args
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().MovingAverageConvergenceDivergenceSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2471,6 +2711,8 @@ func handleMovingAverageConvergenceDivergenceExt(client *alphavantage.Client, ar
 	flags.IntVar(&signalMAType, "signalmatype", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2513,6 +2755,9 @@ func handleMovingAverageConvergenceDivergenceExt(client *alphavantage.Client, ar
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().MovingAverageConvergenceDivergenceExtSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2540,6 +2785,8 @@ func handleMESAAdaptiveMovingAverage(client *alphavantage.Client, args []string,
 	flags.StringVar(&slowLimit, "slowlimit", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2570,6 +2817,9 @@ func handleMESAAdaptiveMovingAverage(client *alphavantage.Client, args []string,
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().MESAAdaptiveMovingAverageSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2611,6 +2861,8 @@ func handleMoneyFlowIndex(client *alphavantage.Client, args []string, output io.
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2635,6 +2887,9 @@ func handleMoneyFlowIndex(client *alphavantage.Client, args []string, output io.
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().MoneyFlowIndexSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2660,6 +2915,8 @@ func handleMidPoint(client *alphavantage.Client, args []string, output io.Writer
 	flags.StringVar(&month, "month", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2687,6 +2944,9 @@ func handleMidPoint(client *alphavantage.Client, args []string, output io.Writer
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().MidPointSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2710,6 +2970,8 @@ func handleMidPrice(client *alphavantage.Client, args []string, output io.Writer
 	flags.StringVar(&month, "month", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2734,6 +2996,9 @@ func handleMidPrice(client *alphavantage.Client, args []string, output io.Writer
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().MidPriceSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2757,6 +3022,8 @@ func handleMinusDirectionalIndicator(client *alphavantage.Client, args []string,
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2781,6 +3048,9 @@ func handleMinusDirectionalIndicator(client *alphavantage.Client, args []string,
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().MinusDirectionalIndicatorSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2804,6 +3074,8 @@ func handleMinusDirectionalMovement(client *alphavantage.Client, args []string,
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2828,6 +3100,9 @@ func handleMinusDirectionalMovement(client *alphavantage.Client, args []string,
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().MinusDirectionalMovementSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2853,6 +3128,8 @@ func handleMomentum(client *alphavantage.Client, args []string, output io.Writer
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2880,6 +3157,9 @@ func handleMomentum(client *alphavantage.Client, args []string, output io.Writer
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().MomentumSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2903,6 +3183,8 @@ func handleNormalizedAverageTrueRange(client *alphavantage.Client, args []string
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2927,6 +3209,9 @@ func handleNormalizedAverageTrueRange(client *alphavantage.Client, args []string
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().NormalizedAverageTrueRangeSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -2944,6 +3229,8 @@ func handleNaturalGas(client *alphavantage.Client, args []string, output io.Writ
 	flags.StringVar(&interval, "interval", "", "options: daily, weekly, monthly")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -2955,6 +3242,9 @@ func handleNaturalGas(client *alphavantage.Client, args []string, output io.Writ
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Commodities().NaturalGasSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3026,6 +3316,8 @@ func handleNonFarmPayroll(client *alphavantage.Client, args []string, output io.
 	flags := pflag.NewFlagSet("NONFARM_PAYROLL", pflag.ContinueOnError)
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3034,6 +3326,9 @@ func handleNonFarmPayroll(client *alphavantage.Client, args []string, output io.
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Economic().NonFarmPayrollSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3055,6 +3350,8 @@ func handleOnBalanceVolume(client *alphavantage.Client, args []string, output io
 	flags.StringVar(&month, "month", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3076,6 +3373,9 @@ func handleOnBalanceVolume(client *alphavantage.Client, args []string, output io
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().OnBalanceVolumeSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3122,6 +3422,8 @@ func handlePlusDirectionalIndicator(client *alphavantage.Client, args []string,
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3146,6 +3448,9 @@ func handlePlusDirectionalIndicator(client *alphavantage.Client, args []string,
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().PlusDirectionalIndicatorSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3169,6 +3474,8 @@ func handlePlusDirectionalMovement(client *alphavantage.Client, args []string, o
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3193,6 +3500,9 @@ func handlePlusDirectionalMovement(client *alphavantage.Client, args []string, o
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().PlusDirectionalMovementSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3222,6 +3532,8 @@ func handlePercentagePriceOscillator(client *alphavantage.Client, args []string,
 	flags.IntVar(&movingAverageType, "matype", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3255,6 +3567,9 @@ func handlePercentagePriceOscillator(client *alphavantage.Client, args []string,
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().PercentagePriceOscillatorSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3272,6 +3587,8 @@ func handleRealtimeBulkQuotes(client *alphavantage.Client, args []string, output
 	flags.StringVar(&symbol, "symbol", "", "[REQUIRED]")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3283,6 +3600,9 @@ func handleRealtimeBulkQuotes(client *alphavantage.Client, args []string, output
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.TimeSeries().RealtimeBulkQuotesSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3338,6 +3658,8 @@ func handleRealGDP(client *alphavantage.Client, args []string, output io.Writer)
 	flags.StringVar(&interval, "interval", "", "options: quarterly, annual")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3349,6 +3671,9 @@ func handleRealGDP(client *alphavantage.Client, args []string, output io.Writer)
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Economic().RealGDPSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3364,6 +3689,8 @@ func handleRealGDPPerCapita(client *alphavantage.Client, args []string, output i
 	flags := pflag.NewFlagSet("REAL_GDP_PER_CAPITA", pflag.ContinueOnError)
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3372,6 +3699,9 @@ func handleRealGDPPerCapita(client *alphavantage.Client, args []string, output i
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Economic().RealGDPPerCapitaSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3387,6 +3717,8 @@ func handleRetailSales(client *alphavantage.Client, args []string, output io.Wri
 	flags := pflag.NewFlagSet("RETAIL_SALES", pflag.ContinueOnError)
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3395,6 +3727,9 @@ func handleRetailSales(client *alphavantage.Client, args []string, output io.Wri
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Economic().RetailSalesSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3420,6 +3755,8 @@ func handleRateOfChange(client *alphavantage.Client, args []string, output io.Wr
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3447,6 +3784,9 @@ func handleRateOfChange(client *alphavantage.Client, args []string, output io.Wr
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().RateOfChangeSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3472,6 +3812,8 @@ func handleRateOfChangeRatio(client *alphavantage.Client, args []string, output
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3499,6 +3841,9 @@ func handleRateOfChangeRatio(client *alphavantage.Client, args []string, output
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().RateOfChangeRatioSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3524,6 +3869,8 @@ func handleRelativeStrengthIndex(client *alphavantage.Client, args []string, out
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3551,6 +3898,9 @@ func handleRelativeStrengthIndex(client *alphavantage.Client, args []string, out
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().RelativeStrengthIndexSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3576,6 +3926,8 @@ func handleSAR(client *alphavantage.Client, args []string, output io.Writer) err
 	flags.StringVar(&maximum, "maximum", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3603,6 +3955,9 @@ func handleSAR(client *alphavantage.Client, args []string, output io.Writer) err
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().SARSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3620,6 +3975,8 @@ func handleSharesOutstanding(client *alphavantage.Client, args []string, output
 	flags.StringVar(&symbol, "symbol", "", "[REQUIRED]")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3631,6 +3988,9 @@ func handleSharesOutstanding(client *alphavantage.Client, args []string, output
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Fundamental().SharesOutstandingSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3656,6 +4016,8 @@ func handleSimpleMovingAverage(client *alphavantage.Client, args []string, outpu
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3683,6 +4045,9 @@ func handleSimpleMovingAverage(client *alphavantage.Client, args []string, outpu
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().SimpleMovingAverageSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3700,6 +4065,8 @@ func handleSplits(client *alphavantage.Client, args []string, output io.Writer)
 	flags.StringVar(&symbol, "symbol", "", "[REQUIRED]")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3711,6 +4078,9 @@ func handleSplits(client *alphavantage.Client, args []string, output io.Writer)
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Fundamental().SplitsSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3742,6 +4112,8 @@ func handleStochasticOscillator(client *alphavantage.Client, args []string, outp
 	flags.IntVar(&slowDMAType, "slowdmatype", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3778,6 +4150,9 @@ func handleStochasticOscillator(client *alphavantage.Client, args []string, outp
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().StochasticOscillatorSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3805,6 +4180,8 @@ func handleStochasticFast(client *alphavantage.Client, args []string, output io.
 	flags.IntVar(&fastDMAType, "fastdmatype", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3835,6 +4212,9 @@ func handleStochasticFast(client *alphavantage.Client, args []string, output io.
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().StochasticFastSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3866,6 +4246,8 @@ func handleStochasticRelativeStrengthIndex(client *alphavantage.Client, args []s
 	flags.IntVar(&fastDMAType, "fastdmatype", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3902,6 +4284,9 @@ func handleStochasticRelativeStrengthIndex(client *alphavantage.Client, args []s
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().StochasticRelativeStrengthIndexSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3919,6 +4304,8 @@ func handleSugar(client *alphavantage.Client, args []string, output io.Writer) e
 	flags.StringVar(&interval, "interval", "", "options: daily, weekly, monthly")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3930,6 +4317,9 @@ func handleSugar(client *alphavantage.Client, args []string, output io.Writer) e
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Commodities().SugarSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3947,6 +4337,8 @@ func handleSymbolSearch(client *alphavantage.Client, args []string, output io.Wr
 	flags.StringVar(&keywords, "keywords", "", "[REQUIRED]")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -3958,6 +4350,9 @@ func handleSymbolSearch(client *alphavantage.Client, args []string, output io.Wr
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.TimeSeries().SymbolSearchSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -3983,6 +4378,8 @@ func handleT3(client *alphavantage.Client, args []string, output io.Writer) erro
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4010,6 +4407,9 @@ func handleT3(client *alphavantage.Client, args []string, output io.Writer) erro
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().T3Seq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4035,6 +4435,8 @@ func handleTripleExponentialMovingAverage(client *alphavantage.Client, args []st
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4062,6 +4464,9 @@ func handleTripleExponentialMovingAverage(client *alphavantage.Client, args []st
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().TripleExponentialMovingAverageSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4081,6 +4486,8 @@ func handleTimeSeriesDaily(client *alphavantage.Client, args []string, output io
 	flags.StringVar(&outputSize, "outputsize", "", "options: compact, full")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4095,6 +4502,9 @@ func handleTimeSeriesDaily(client *alphavantage.Client, args []string, output io
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.TimeSeries().DailySeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4114,6 +4524,8 @@ func handleTimeSeriesDailyAdjusted(client *alphavantage.Client, args []string, o
 	flags.StringVar(&outputSize, "outputsize", "", "options: compact, full")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4128,6 +4540,9 @@ func handleTimeSeriesDailyAdjusted(client *alphavantage.Client, args []string, o
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.TimeSeries().DailyAdjustedSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4155,6 +4570,8 @@ func handleTimeSeriesIntraday(client *alphavantage.Client, args []string, output
 	flags.StringVar(&outputSize, "outputsize", "", "options: compact, full")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4185,6 +4602,9 @@ func handleTimeSeriesIntraday(client *alphavantage.Client, args []string, output
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.TimeSeries().IntradaySeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4202,6 +4622,8 @@ func handleTimeSeriesMonthly(client *alphavantage.Client, args []string, output
 	flags.StringVar(&symbol, "symbol", "", "[REQUIRED]")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4213,6 +4635,9 @@ func handleTimeSeriesMonthly(client *alphavantage.Client, args []string, output
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.TimeSeries().MonthlySeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4230,6 +4655,8 @@ func handleTimeSeriesMonthlyAdjusted(client *alphavantage.Client, args []string,
 	flags.StringVar(&symbol, "symbol", "", "[REQUIRED]")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4241,6 +4668,9 @@ func handleTimeSeriesMonthlyAdjusted(client *alphavantage.Client, args []string,
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.TimeSeries().MonthlyAdjustedSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4258,6 +4688,8 @@ func handleTimeSeriesWeekly(client *alphavantage.Client, args []string, output i
 	flags.StringVar(&symbol, "symbol", "", "[REQUIRED]")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4269,6 +4701,9 @@ func handleTimeSeriesWeekly(client *alphavantage.Client, args []string, output i
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.TimeSeries().WeeklySeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4286,6 +4721,8 @@ func handleTimeSeriesWeeklyAdjusted(client *alphavantage.Client, args []string,
 	flags.StringVar(&symbol, "symbol", "", "[REQUIRED]")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4297,6 +4734,9 @@ func handleTimeSeriesWeeklyAdjusted(client *alphavantage.Client, args []string,
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.TimeSeries().WeeklyAdjustedSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4336,6 +4776,8 @@ func handleTrueRange(client *alphavantage.Client, args []string, output io.Write
 	flags.StringVar(&month, "month", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4357,6 +4799,9 @@ func handleTrueRange(client *alphavantage.Client, args []string, output io.Write
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().TrueRangeSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4376,6 +4821,8 @@ func handleTreasuryYield(client *alphavantage.Client, args []string, output io.W
 	flags.StringVar(&maturity, "maturity", "", "options: 3month, 2year, 5year, 7year, 10year, 30year")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4390,6 +4837,9 @@ func handleTreasuryYield(client *alphavantage.Client, args []string, output io.W
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Economic().TreasuryYieldSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4415,6 +4865,8 @@ func handleTriangularMovingAverage(client *alphavantage.Client, args []string, o
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4442,6 +4894,9 @@ func handleTriangularMovingAverage(client *alphavantage.Client, args []string, o
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().TriangularMovingAverageSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4467,6 +4922,8 @@ func handleOneDayRateOfChangeTripleSmoothExponentialMovingAverage(client *alphav
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4494,6 +4951,9 @@ func handleOneDayRateOfChangeTripleSmoothExponentialMovingAverage(client *alphav
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().OneDayRateOfChangeTripleSmoothExponentialMovingAverageSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4521,6 +4981,8 @@ func handleUltimateOscillator(client *alphavantage.Client, args []string, output
 	flags.IntVar(&timePeriod3, "timeperiod3", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4551,6 +5013,9 @@ func handleUltimateOscillator(client *alphavantage.Client, args []string, output
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().UltimateOscillatorSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4566,6 +5031,8 @@ func handleUnemployment(client *alphavantage.Client, args []string, output io.Wr
 	flags := pflag.NewFlagSet("UNEMPLOYMENT", pflag.ContinueOnError)
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4574,6 +5041,9 @@ func handleUnemployment(client *alphavantage.Client, args []string, output io.Wr
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Economic().UnemploymentSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4595,6 +5065,8 @@ func handleVolumeWeightedAveragePrice(client *alphavantage.Client, args []string
 	flags.StringVar(&month, "month", "", "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4616,6 +5088,9 @@ func handleVolumeWeightedAveragePrice(client *alphavantage.Client, args []string
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().VolumeWeightedAveragePriceSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4633,6 +5108,8 @@ func handleWheat(client *alphavantage.Client, args []string, output io.Writer) e
 	flags.StringVar(&interval, "interval", "", "options: daily, weekly, monthly")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4644,6 +5121,9 @@ func handleWheat(client *alphavantage.Client, args []string, output io.Writer) e
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Commodities().WheatSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4667,6 +5147,8 @@ func handleWilliamsR(client *alphavantage.Client, args []string, output io.Write
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4691,6 +5173,9 @@ func handleWilliamsR(client *alphavantage.Client, args []string, output io.Write
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().WilliamsRSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4716,6 +5201,8 @@ func handleWeightedMovingAverage(client *alphavantage.Client, args []string, out
 	flags.IntVar(&timePeriod, "time-period", 0, "")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4743,6 +5230,9 @@ func handleWeightedMovingAverage(client *alphavantage.Client, args []string, out
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Technical().WeightedMovingAverageSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
@@ -4760,6 +5250,8 @@ func handleWestTexasIntermediate(client *alphavantage.Client, args []string, out
 	flags.StringVar(&interval, "interval", "", "options: daily, weekly, monthly")
 	var dataType string
 	flags.StringVar(&dataType, "datatype", "", "options: csv, json")
+	var jsonl bool
+	flags.BoolVar(&jsonl, "jsonl", false, "convert the CSV response to newline-delimited JSON")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -4771,6 +5263,9 @@ func handleWestTexasIntermediate(client *alphavantage.Client, args []string, out
 		query = query.DataType(dataType)
 	}
 	ctx := context.Background()
+	if jsonl {
+		return writeJSONL(output, client.Commodities().WestTexasIntermediateSeq(ctx, query.DataTypeCSV()))
+	}
 	res, err := client.Query(ctx, query)
 	if err != nil {
 		return fmt.Errorf("API request failed: %w", err)
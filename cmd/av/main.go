@@ -1,11 +1,15 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"iter"
 	"os"
 	"runtime/debug"
 
 	"github.com/portfoliotree/alphavantage"
+	"github.com/portfoliotree/alphavantage/api"
 )
 
 func main() {
@@ -53,6 +57,27 @@ func help() {
 	fmt.Println("  av GLOBAL_QUOTE --symbol=IBM")
 	fmt.Println("  av TIME_SERIES_DAILY --symbol=AAPL --outputsize=full -o aapl.csv")
 	fmt.Println("  av SMA --symbol=MSFT --interval=daily --time-period=20 --series-type=close")
+	fmt.Println("  av TIME_SERIES_INTRADAY --symbol=IBM --interval=5min --jsonl")
 	fmt.Println()
 	fmt.Println("Documentation: https://www.alphavantage.co/documentation/")
 }
+
+// writeJSONL writes rows as newline-delimited JSON as they are parsed.
+// Rows encoded before a request error are still written.
+func writeJSONL[T any](output io.Writer, rows iter.Seq2[T, error]) (err error) {
+	e := api.NewJSONLEncoder[T](output)
+	defer func() {
+		if flushErr := e.Flush(); flushErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to write response: %w", flushErr))
+		}
+	}()
+	for row, err := range rows {
+		if err != nil {
+			return fmt.Errorf("API request failed: %w", err)
+		}
+		if err := e.Encode(row); err != nil {
+			return fmt.Errorf("failed to write response: %w", err)
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -43,6 +44,10 @@ func TestMain(m *testing.M) {
 		// Match request to index entry
 		reqParams := r.URL.Query()
 		reqParams.Del("apikey")
+		// the CSV examples were fetched without a datatype parameter
+		if reqParams.Get("datatype") == "csv" {
+			reqParams.Del("datatype")
+		}
 
 		for _, entry := range index {
 			entryURL, err := url.Parse(entry.URL)
@@ -186,6 +191,61 @@ func TestWTI(t *testing.T) {
 	}
 }
 
+func TestTimeSeriesDailyJSONL(t *testing.T) {
+	cmd := exec.Command("go", "run", ".", "TIME_SERIES_DAILY", "--symbol=IBM", "--jsonl")
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("command failed: %v\nOutput: %s", err, output)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	if len(lines) < 2 {
+		t.Fatalf("expected a line per row, got: %s", output)
+	}
+	var row map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &row); err != nil {
+		t.Fatalf("failed to parse line %q: %v", lines[0], err)
+	}
+	if ts, ok := row["timestamp"].(string); !ok || !strings.HasSuffix(ts, "T00:00:00Z") {
+		t.Errorf("expected an RFC 3339 timestamp, got: %v", row["timestamp"])
+	}
+	if _, ok := row["close"].(float64); !ok {
+		t.Errorf("expected close to be a number, got: %v", row["close"])
+	}
+}
+
+func TestSMAJSONL(t *testing.T) {
+	cmd := exec.Command("go", "run", ".", "SMA", "--symbol=IBM", "--interval=weekly", "--time-period=10", "--series-type=open", "--jsonl")
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("command failed: %v\nOutput: %s", err, output)
+	}
+
+	if !strings.HasPrefix(string(output), `{"time":"`) || !strings.Contains(string(output), `"sma":`) {
+		t.Errorf("expected snake_case keys, got: %.200s", output)
+	}
+}
+
+func TestWriteJSONLRequestError(t *testing.T) {
+	type row struct {
+		Close float64 `column-name:"close"`
+	}
+	rows := func(yield func(row, error) bool) {
+		if yield(row{Close: 1.5}, nil) {
+			yield(row{}, errors.New("rate limited"))
+		}
+	}
+
+	var output strings.Builder
+	err := writeJSONL(&output, rows)
+	if err == nil || !strings.Contains(err.Error(), "API request failed: rate limited") {
+		t.Errorf("expected the request error, got: %v", err)
+	}
+	if got := output.String(); got != `{"close":1.5}`+"\n" {
+		t.Errorf("expected the row before the error to be written, got: %q", got)
+	}
+}
+
 func TestHelp(t *testing.T) {
 	cmd := exec.Command("go", "run", ".", "help")
 	output, err := cmd.CombinedOutput()
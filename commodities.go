@@ -0,0 +1,185 @@
+package alphavantage
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/portfoliotree/alphavantage/query/commodities"
+	"github.com/portfoliotree/alphavantage/query/forex"
+)
+
+// Fetch requests the prices of any commodity and returns them from the oldest
+// to the most recent. The empty interval requests the default interval of
+// the commodity; other intervals must be listed in its catalog Metadata.
+// Prices AlphaVantage sends as "." or another of api.DefaultNullValues are
+// Missing.
+func (f *CommoditiesFunctions) Fetch(ctx context.Context, commodity commodities.Commodity, interval string) ([]commodities.Observation, error) {
+	metadata, ok := commodity.Metadata()
+	if !ok {
+		return nil, fmt.Errorf("unknown commodity %q", commodity)
+	}
+	if !metadata.AcceptsInterval(interval) {
+		return nil, fmt.Errorf("%s does not support interval %q", commodity, interval)
+	}
+	rows, err := (*Client)(f).queryObservations(ctx, string(commodity), interval)
+	if err != nil {
+		return nil, err
+	}
+	observations := make([]commodities.Observation, len(rows))
+	for i, o := range rows {
+		observations[i] = commodities.Observation(o)
+	}
+	return observations, nil
+}
+
+// Panel fetches the prices of each commodity with interval concurrently and
+// aligns them on the union of their dates. The panel symbols are the
+// commodity function names and missing prices are NaN.
+//
+// When currency is neither empty nor the currency of a commodity, its prices
+// are converted with the FX_DAILY closing rates: a daily price uses the rate
+// of its date (or the latest one before it) and the price of a longer period
+// uses the average rate over the period. The index (ALL_COMMODITIES) has no
+// currency and is not converted. Call Rebase on the result to compare the
+// commodities regardless of their units.
+func (f *CommoditiesFunctions) Panel(ctx context.Context, interval, currency string, list ...commodities.Commodity) (Panel, error) {
+	keys := make([]string, len(list))
+	for i, c := range list {
+		keys[i] = string(c)
+	}
+	series, err := fetchEach(ctx, keys, func(ctx context.Context, key string) ([]commodities.Observation, error) {
+		observations, err := f.Fetch(ctx, commodities.Commodity(key), interval)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s prices: %w", key, err)
+		}
+		return observations, nil
+	})
+	if err != nil {
+		return Panel{}, err
+	}
+	panel := NewPanel(Union, keys, series,
+		func(o commodities.Observation) time.Time { return o.Date },
+		func(o commodities.Observation) float64 {
+			if o.Missing {
+				return math.NaN()
+			}
+			return o.Value
+		},
+	)
+	if currency == "" {
+		return panel, nil
+	}
+	factors := make(map[string][]float64)
+	for i, c := range list {
+		metadata, _ := c.Metadata()
+		if metadata.Currency == "" || metadata.Currency == currency {
+			continue
+		}
+		if _, ok := factors[metadata.Currency]; !ok {
+			rows, err := (*Client)(f).Forex().Daily(ctx, forex.QueryDaily(f.APIKey, metadata.Currency, currency).OutputSizeFull().DataTypeCSV())
+			if err != nil {
+				return Panel{}, fmt.Errorf("failed to get %s/%s exchange rates: %w", metadata.Currency, currency, err)
+			}
+			rates, err := exchangeRates(rows)
+			if err != nil {
+				return Panel{}, err
+			}
+			factors[metadata.Currency] = periodRates(panel.Dates, interval, rates)
+		}
+		for j, factor := range factors[metadata.Currency] {
+			panel.Columns[i][j] *= factor
+		}
+	}
+	return panel, nil
+}
+
+// ExchangeRate is the price of one unit of a currency in another currency.
+type ExchangeRate struct {
+	Date time.Time
+	Rate float64
+}
+
+// ConvertCurrency returns a copy of prices with every value multiplied by the
+// exchange rate of its period, as described for CommoditiesFunctions.Panel.
+// The rates may be in any order. Values of periods without a rate are NaN.
+func ConvertCurrency(prices Panel, interval string, rates []ExchangeRate) Panel {
+	factors := periodRates(prices.Dates, interval, rates)
+	converted := Panel{
+		Dates:   slices.Clone(prices.Dates),
+		Symbols: slices.Clone(prices.Symbols),
+		Columns: make([][]float64, len(prices.Columns)),
+	}
+	for i, column := range prices.Columns {
+		column = slices.Clone(column)
+		for j := range column {
+			column[j] *= factors[j]
+		}
+		converted.Columns[i] = column
+	}
+	return converted
+}
+
+func exchangeRates(rows []forex.DailyRow) ([]ExchangeRate, error) {
+	rates := make([]ExchangeRate, 0, len(rows))
+	for _, row := range rows {
+		date, err := time.Parse(time.DateOnly, row.TimeStamp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse exchange rate date %q: %w", row.TimeStamp, err)
+		}
+		rate, err := strconv.ParseFloat(row.Close, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse exchange rate %q on %s: %w", row.Close, row.TimeStamp, err)
+		}
+		rates = append(rates, ExchangeRate{Date: date, Rate: rate})
+	}
+	return rates, nil
+}
+
+// periodRates returns the rate to convert a price of interval dated on each
+// of dates. Daily prices use the latest rate on or before the date, weekly
+// prices (dated at the end of the week) the average rate of the seven days
+// ending on the date and longer periods (dated at their start) the average
+// rate of the period.
+func periodRates(dates []time.Time, interval string, rates []ExchangeRate) []float64 {
+	rates = slices.Clone(rates)
+	slices.SortFunc(rates, func(a, b ExchangeRate) int { return a.Date.Compare(b.Date) })
+	find := func(d time.Time) int {
+		i, _ := slices.BinarySearchFunc(rates, d, func(r ExchangeRate, d time.Time) int { return r.Date.Compare(d) })
+		return i
+	}
+	average := func(from, to time.Time) float64 {
+		sum, n := 0.0, 0
+		for i := find(from); i < len(rates) && rates[i].Date.Before(to); i++ {
+			sum += rates[i].Rate
+			n++
+		}
+		if n == 0 {
+			return math.NaN()
+		}
+		return sum / float64(n)
+	}
+	factors := make([]float64, len(dates))
+	for j, d := range dates {
+		switch interval {
+		case "daily":
+			i := find(d.AddDate(0, 0, 1))
+			factors[j] = math.NaN()
+			if i > 0 {
+				factors[j] = rates[i-1].Rate
+			}
+		case "weekly":
+			factors[j] = average(d.AddDate(0, 0, -6), d.AddDate(0, 0, 1))
+		case "quarterly":
+			factors[j] = average(d, d.AddDate(0, 3, 0))
+		case "annual":
+			factors[j] = average(d, d.AddDate(1, 0, 0))
+		default:
+			factors[j] = average(d, d.AddDate(0, 1, 0))
+		}
+	}
+	return factors
+}
@@ -0,0 +1,78 @@
+package alphavantage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/portfoliotree/alphavantage/query/economic"
+)
+
+// observationRow is the shape of every economic indicator CSV response.
+type observationRow struct {
+	TimeStamp time.Time `column-name:"timestamp" time-layout:"2006-01-02"`
+	Value     *float64  `column-name:"value"`
+}
+
+// Fetch requests any economic indicator and returns its observations from
+// the oldest to the most recent. The empty interval requests the default
+// frequency of the indicator; other intervals must be listed in its catalog
+// Metadata. Values AlphaVantage sends as "." or another of
+// api.DefaultNullValues are Missing.
+//
+// TREASURY_YIELD is fetched with its default maturity; use TreasuryYield or
+// YieldCurve to choose maturities.
+func (f *EconomicFunctions) Fetch(ctx context.Context, indicator economic.Indicator, interval string) ([]economic.Observation, error) {
+	metadata, ok := indicator.Metadata()
+	if !ok {
+		return nil, fmt.Errorf("unknown economic indicator %q", indicator)
+	}
+	if !metadata.AcceptsInterval(interval) {
+		return nil, fmt.Errorf("%s does not support interval %q", indicator, interval)
+	}
+	rows, err := (*Client)(f).queryObservations(ctx, string(indicator), interval)
+	if err != nil {
+		return nil, err
+	}
+	observations := make([]economic.Observation, len(rows))
+	for i, o := range rows {
+		observations[i] = economic.Observation(o)
+	}
+	return observations, nil
+}
+
+// observation is the common shape of economic.Observation and
+// commodities.Observation.
+type observation struct {
+	Date    time.Time
+	Value   float64
+	Missing bool
+}
+
+// queryObservations requests a timestamp and value CSV series, such as an
+// economic indicator or a commodity price, and returns it oldest first.
+func (c *Client) queryObservations(ctx context.Context, function, interval string) ([]observation, error) {
+	query := url.Values{
+		"function": []string{function},
+		"apikey":   []string{c.APIKey},
+		"datatype": []string{"csv"},
+	}
+	if interval != "" {
+		query.Set("interval", interval)
+	}
+	rows, err := queryRows[observationRow](ctx, c, query)
+	if err != nil {
+		return nil, err
+	}
+	observations := make([]observation, len(rows))
+	for i, row := range rows {
+		observations[i] = observation{Date: row.TimeStamp, Missing: row.Value == nil}
+		if row.Value != nil {
+			observations[i].Value = *row.Value
+		}
+	}
+	slices.SortStableFunc(observations, func(a, b observation) int { return a.Date.Compare(b.Date) })
+	return observations, nil
+}
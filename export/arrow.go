@@ -0,0 +1,178 @@
+package export
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// Arrow IPC constants from Schema.fbs and Message.fbs.
+const (
+	arrowMetadataV5 = 4
+
+	arrowHeaderSchema      = 1
+	arrowHeaderRecordBatch = 3
+
+	arrowTypeInt           = 2
+	arrowTypeFloatingPoint = 3
+	arrowTypeUtf8          = 5
+	arrowTypeBool          = 6
+	arrowTypeDate          = 8
+	arrowTypeTimestamp     = 10
+	arrowTypeDuration      = 18
+
+	arrowPrecisionDouble = 2
+	arrowDateUnitDay     = 0
+)
+
+// arrowContinuation starts every message of an IPC stream.
+const arrowContinuation = 0xFFFFFFFF
+
+// WriteArrow writes the table as an Arrow IPC stream: a schema message, one
+// record batch and the end of stream marker. Files with the ".arrows"
+// extension conventionally hold streams.
+func (t *Table) WriteArrow(w io.Writer) error {
+	if err := writeArrowMessage(w, arrowHeaderSchema, t.arrowSchema(), nil); err != nil {
+		return err
+	}
+	batch, body := t.arrowRecordBatch()
+	if err := writeArrowMessage(w, arrowHeaderRecordBatch, batch, body); err != nil {
+		return err
+	}
+	_, err := w.Write(binary.LittleEndian.AppendUint32(binary.LittleEndian.AppendUint32(nil, arrowContinuation), 0))
+	return err
+}
+
+// writeArrowMessage writes the continuation marker, the metadata length, the
+// Message metadata padded to 8 bytes and the body.
+func writeArrowMessage(w io.Writer, headerType uint8, header fbTable, body []byte) error {
+	metadata := encodeFlatBuffer(fbTable{
+		fbInt16(arrowMetadataV5),
+		fbUint8(headerType),
+		header,
+		fbInt64(int64(len(body))),
+	})
+	for len(metadata)%8 != 0 {
+		metadata = append(metadata, 0)
+	}
+	prefix := binary.LittleEndian.AppendUint32(nil, arrowContinuation)
+	prefix = binary.LittleEndian.AppendUint32(prefix, uint32(len(metadata)))
+	for _, b := range [][]byte{prefix, metadata, body} {
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *Table) arrowSchema() fbTable {
+	fields := make(fbTables, len(t.Columns))
+	for i, c := range t.Columns {
+		typeType, typ := arrowType(c)
+		fields[i] = fbTable{
+			fbString(c.Name),
+			fbBool(true),
+			fbUint8(typeType),
+			typ,
+			nil,
+			fbTables{},
+		}
+	}
+	return fbTable{nil, fields}
+}
+
+// arrowType returns the Type union of the Field table of c.
+func arrowType(c *Column) (uint8, fbTable) {
+	switch c.Type {
+	case Bool:
+		return arrowTypeBool, fbTable{}
+	case Int64:
+		return arrowTypeInt, fbTable{fbInt32(64), fbBool(true)}
+	case Float64:
+		return arrowTypeFloatingPoint, fbTable{fbInt16(arrowPrecisionDouble)}
+	case String:
+		return arrowTypeUtf8, fbTable{}
+	case Date:
+		return arrowTypeDate, fbTable{fbInt16(arrowDateUnitDay)}
+	case Timestamp:
+		timestamp := fbTable{fbInt16(int16(c.Unit))}
+		if c.TimeZone != "" && c.TimeZone != "Local" {
+			timestamp = append(timestamp, fbString(c.TimeZone))
+		}
+		return arrowTypeTimestamp, timestamp
+	default:
+		return arrowTypeDuration, fbTable{fbInt16(int16(Nanosecond))}
+	}
+}
+
+// arrowRecordBatch returns the RecordBatch table and the body with the
+// buffers of every column, each padded to 8 bytes.
+func (t *Table) arrowRecordBatch() (fbTable, []byte) {
+	var nodes, buffers, body []byte
+	addBuffer := func(b []byte) {
+		buffers = binary.LittleEndian.AppendUint64(buffers, uint64(len(body)))
+		buffers = binary.LittleEndian.AppendUint64(buffers, uint64(len(b)))
+		body = append(body, b...)
+		for len(body)%8 != 0 {
+			body = append(body, 0)
+		}
+	}
+	bufferCount := 0
+	for _, c := range t.Columns {
+		nodes = binary.LittleEndian.AppendUint64(nodes, uint64(t.Rows))
+		nodes = binary.LittleEndian.AppendUint64(nodes, uint64(c.NullCount))
+		// the validity buffer may be empty when there are no nulls
+		var validity []byte
+		if c.NullCount > 0 {
+			validity = c.Valid
+		}
+		for _, b := range append([][]byte{validity}, arrowBuffers(c)...) {
+			addBuffer(b)
+			bufferCount++
+		}
+	}
+	return fbTable{
+		fbInt64(int64(t.Rows)),
+		fbStructs{count: len(t.Columns), data: nodes},
+		fbStructs{count: bufferCount, data: buffers},
+	}, body
+}
+
+// arrowBuffers returns the buffers of c after its validity buffer.
+func arrowBuffers(c *Column) [][]byte {
+	switch c.Type {
+	case Bool:
+		var values Bitmap
+		for i, b := range c.Bools {
+			values.append(i, b)
+		}
+		return [][]byte{values}
+	case Float64:
+		values := make([]byte, 0, 8*len(c.Float64s))
+		for _, f := range c.Float64s {
+			values = binary.LittleEndian.AppendUint64(values, math.Float64bits(f))
+		}
+		return [][]byte{values}
+	case String:
+		offsets := make([]byte, 0, 4*(len(c.Strings)+1))
+		var data []byte
+		offsets = binary.LittleEndian.AppendUint32(offsets, 0)
+		for _, s := range c.Strings {
+			data = append(data, s...)
+			offsets = binary.LittleEndian.AppendUint32(offsets, uint32(len(data)))
+		}
+		return [][]byte{offsets, data}
+	case Date:
+		values := make([]byte, 0, 4*len(c.Int32s))
+		for _, v := range c.Int32s {
+			values = binary.LittleEndian.AppendUint32(values, uint32(v))
+		}
+		return [][]byte{values}
+	default:
+		values := make([]byte, 0, 8*len(c.Int64s))
+		for _, v := range c.Int64s {
+			values = binary.LittleEndian.AppendUint64(values, uint64(v))
+		}
+		return [][]byte{values}
+	}
+}
@@ -0,0 +1,284 @@
+package export_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/portfoliotree/alphavantage/api"
+	"github.com/portfoliotree/alphavantage/export"
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+)
+
+type row struct {
+	Time   time.Time     `column-name:"time" time-layout:"2006-01-02 15:04:05.000"`
+	Day    *time.Time    `column-name:"day"`
+	Close  float64       `column-name:"close"`
+	Volume uint32        `column-name:"volume"`
+	Name   string        `column-name:"name"`
+	Halted bool          `column-name:"halted"`
+	Delay  time.Duration `column-name:"delay"`
+	Epoch  time.Time     `column-name:"epoch" time-unit:"s"`
+	Note   string
+}
+
+func rows() []row {
+	day := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	return []row{
+		{Time: time.Date(2024, 1, 2, 9, 30, 0, 5e6, time.UTC), Day: &day, Close: 1.5, Volume: 10, Name: "abc", Delay: time.Second},
+		{Close: math.NaN(), Halted: true},
+		{Time: time.Date(2024, 1, 3, 9, 30, 0, 0, time.UTC), Close: 2, Name: "héllo", Epoch: time.Unix(60, 0)},
+	}
+}
+
+func TestFromRows(t *testing.T) {
+	table, err := export.FromRows(rows())
+	require.NoError(t, err)
+	assert.Equal(t, 3, table.Rows)
+
+	var names []string
+	for _, c := range table.Columns {
+		names = append(names, c.Name)
+	}
+	assert.Equal(t, []string{"time", "day", "close", "volume", "name", "halted", "delay", "epoch"}, names)
+
+	tm := table.Columns[0]
+	assert.Equal(t, export.Timestamp, tm.Type)
+	assert.Equal(t, export.Millisecond, tm.Unit)
+	assert.Equal(t, "UTC", tm.TimeZone)
+	assert.Equal(t, []int64{1704187800005, 0, 1704274200000}, tm.Int64s)
+	assert.Equal(t, 1, tm.NullCount)
+	assert.True(t, tm.Valid.Valid(0))
+	assert.False(t, tm.Valid.Valid(1))
+	assert.True(t, tm.Valid.Valid(2))
+
+	day := table.Columns[1]
+	assert.Equal(t, export.Date, day.Type)
+	assert.Equal(t, []int32{19724, 0, 0}, day.Int32s)
+	assert.Equal(t, 2, day.NullCount)
+
+	closes := table.Columns[2]
+	assert.Equal(t, export.Float64, closes.Type)
+	assert.Equal(t, []float64{1.5, 0, 2}, closes.Float64s)
+	assert.Equal(t, export.Bitmap{0b101}, closes.Valid)
+
+	assert.Equal(t, export.Int64, table.Columns[3].Type)
+	assert.Equal(t, []int64{10, 0, 0}, table.Columns[3].Int64s)
+	assert.Equal(t, []string{"abc", "", "héllo"}, table.Columns[4].Strings)
+	assert.Zero(t, table.Columns[4].NullCount)
+	assert.Equal(t, []bool{false, true, false}, table.Columns[5].Bools)
+	assert.Equal(t, export.Duration, table.Columns[6].Type)
+	assert.Equal(t, []int64{1e9, 0, 0}, table.Columns[6].Int64s)
+
+	epoch := table.Columns[7]
+	assert.Equal(t, export.Second, epoch.Unit)
+	assert.Equal(t, []int64{0, 0, 60}, epoch.Int64s)
+	assert.Equal(t, 2, epoch.NullCount)
+}
+
+func TestFromSeq(t *testing.T) {
+	f, err := os.Open("../specification/testdata/examples/time_series/TIME_SERIES_INTRADAY_3dbdcdc7.csv")
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+
+	table, err := export.FromSeq(api.Rows[timeseries.IntradayRow](f, nil))
+	require.NoError(t, err)
+	require.Len(t, table.Columns, 6)
+	assert.Equal(t, 100, table.Rows)
+
+	timestamp := table.Columns[0]
+	assert.Equal(t, "timestamp", timestamp.Name)
+	assert.Equal(t, export.Timestamp, timestamp.Type)
+	assert.Equal(t, export.Second, timestamp.Unit)
+	assert.Equal(t, time.Date(2026, 5, 15, 19, 55, 0, 0, time.UTC).Unix(), timestamp.Int64s[0])
+	assert.Equal(t, 219.03, table.Columns[1].Float64s[0])
+	assert.Equal(t, int64(158), table.Columns[5].Int64s[0])
+}
+
+func TestNewBuilder_errors(t *testing.T) {
+	_, err := export.NewBuilder[int]()
+	assert.ErrorContains(t, err, "not a struct")
+
+	_, err = export.NewBuilder[struct {
+		Values []float64 `column-name:"values"`
+	}]()
+	assert.ErrorContains(t, err, "unsupported type")
+
+	_, err = export.NewBuilder[struct {
+		Time time.Time `column-name:"time" time-unit:"days"`
+	}]()
+	assert.ErrorContains(t, err, "unknown time-unit")
+
+	b, err := export.NewBuilder[struct {
+		Count uint64 `column-name:"count"`
+	}]()
+	require.NoError(t, err)
+	assert.ErrorContains(t, b.Append(struct {
+		Count uint64 `column-name:"count"`
+	}{Count: math.MaxUint64}), "row 1 column count")
+}
+
+// columnValues are the values of rows() as the Arrow record batch holds
+// them; nulls are nil.
+func columnValues() map[string][]any {
+	return map[string][]any{
+		"time":   {int64(1704187800005), nil, int64(1704274200000)},
+		"day":    {int32(19724), nil, nil},
+		"close":  {1.5, nil, 2.0},
+		"volume": {int64(10), int64(0), int64(0)},
+		"name":   {"abc", "", "héllo"},
+		"halted": {false, true, false},
+		"delay":  {int64(1e9), int64(0), int64(0)},
+		"epoch":  {nil, nil, int64(60)},
+	}
+}
+
+func TestTable_WriteArrow(t *testing.T) {
+	table, err := export.FromRows(rows())
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, table.WriteArrow(&buf))
+	messages := readArrowStream(t, buf.Bytes())
+	require.Len(t, messages, 2, "a schema and a record batch")
+
+	schema := messages[0]
+	assert.Equal(t, uint8(1), schema.headerType)
+	assert.Empty(t, schema.body, "the schema has no body")
+	fields := schema.header.tables(1)
+	var types []string
+	for _, field := range fields {
+		assert.True(t, field.bool(1, false), "%s is nullable", field.string(0))
+		assert.Empty(t, field.tables(5), "%s has no children", field.string(0))
+		types = append(types, field.string(0)+": "+arrowTypeString(field))
+	}
+	assert.Equal(t, []string{
+		"time: timestamp[ms, UTC]",
+		"day: date32[day]",
+		"close: double",
+		"volume: int64 signed=true",
+		"name: utf8",
+		"halted: bool",
+		"delay: duration[ns]",
+		"epoch: timestamp[s]", // time.Unix returns Local times
+	}, types)
+
+	batch := messages[1]
+	assert.Equal(t, uint8(3), batch.headerType)
+	assert.Equal(t, int64(3), batch.header.int64(0, 0))
+	nodes := batch.header.int64Structs(1, 2)
+	buffers := batch.header.int64Structs(2, 2)
+	require.Len(t, nodes, len(fields))
+	want := columnValues()
+	for i, field := range fields {
+		name := field.string(0)
+		assert.Equal(t, int64(3), nodes[i][0], name)
+		var values []any
+		values, buffers = arrowValues(t, batch.body, buffers, field.uint8(2, 0), int(nodes[i][0]), int(nodes[i][1]))
+		assert.Equal(t, want[name], values, name)
+	}
+	assert.Empty(t, buffers)
+
+	buf.Reset()
+	empty, err := export.FromRows([]row{})
+	require.NoError(t, err)
+	require.NoError(t, empty.WriteArrow(&buf))
+	messages = readArrowStream(t, buf.Bytes())
+	require.Len(t, messages, 2)
+	assert.Len(t, messages[0].header.tables(1), 8)
+	assert.Zero(t, messages[1].header.int64(0, 0))
+}
+
+func TestTable_WriteParquet(t *testing.T) {
+	table, err := export.FromRows(rows())
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, table.WriteParquet(&buf))
+	out := buf.Bytes()
+
+	require.Greater(t, len(out), 12)
+	assert.Equal(t, "PAR1", string(out[:4]))
+	assert.Equal(t, "PAR1", string(out[len(out)-4:]))
+	footerLength := int(binary.LittleEndian.Uint32(out[len(out)-8:]))
+	footer := &thriftReader{t: t, buf: out[len(out)-8-footerLength : len(out)-8]}
+	metadata := footer.readStruct()
+	assert.Equal(t, footerLength, footer.pos, "the footer is one FileMetaData")
+
+	// FileMetaData: 1 version, 2 schema, 3 num_rows, 4 row_groups
+	assert.Equal(t, int64(1), metadata[1])
+	assert.Equal(t, int64(3), metadata[3])
+
+	// SchemaElement: 1 type, 3 repetition_type (1 is OPTIONAL), 4 name,
+	// 5 num_children, 6 converted_type, 10 logicalType
+	timestampMillis := map[int16]any{8: map[int16]any{1: true, 2: map[int16]any{1: map[int16]any{}}}}
+	schema := metadata[2].([]any)
+	assert.Equal(t, []any{
+		map[int16]any{4: "schema", 5: int64(8)},
+		map[int16]any{1: int64(2), 3: int64(1), 4: "time", 6: int64(9), 10: timestampMillis},
+		map[int16]any{1: int64(1), 3: int64(1), 4: "day", 6: int64(6), 10: map[int16]any{6: map[int16]any{}}},
+		map[int16]any{1: int64(5), 3: int64(1), 4: "close"},
+		map[int16]any{1: int64(2), 3: int64(1), 4: "volume"},
+		map[int16]any{1: int64(6), 3: int64(1), 4: "name", 6: int64(0), 10: map[int16]any{1: map[int16]any{}}},
+		map[int16]any{1: int64(0), 3: int64(1), 4: "halted"},
+		map[int16]any{1: int64(2), 3: int64(1), 4: "delay"},
+		map[int16]any{1: int64(2), 3: int64(1), 4: "epoch", 6: int64(9), 10: timestampMillis},
+	}, schema)
+
+	// RowGroup: 1 columns, 2 total_byte_size, 3 num_rows
+	rowGroups := metadata[4].([]any)
+	require.Len(t, rowGroups, 1)
+	rowGroup := rowGroups[0].(map[int16]any)
+	assert.Equal(t, int64(3), rowGroup[3])
+	want := columnValues()
+	want["epoch"] = []any{nil, nil, int64(60000)} // seconds are written as milliseconds
+	var total int64
+	columns := rowGroup[1].([]any)
+	require.Len(t, columns, 8)
+	for i, column := range columns {
+		// ColumnMetaData: 1 type, 2 encodings, 3 path_in_schema, 4 codec,
+		// 5 num_values, 6 total_uncompressed_size, 7 total_compressed_size,
+		// 9 data_page_offset
+		meta := column.(map[int16]any)[3].(map[int16]any)
+		name := meta[3].([]any)[0].(string)
+		assert.Equal(t, schema[i+1].(map[int16]any)[4], name)
+		assert.Equal(t, schema[i+1].(map[int16]any)[1], meta[1], name)
+		assert.Equal(t, []any{int64(0), int64(3)}, meta[2], "%s is PLAIN and RLE encoded", name)
+		assert.Equal(t, int64(0), meta[4], "%s is uncompressed", name)
+		assert.Equal(t, int64(3), meta[5], name)
+		offset, size := meta[9].(int64), meta[6].(int64)
+		assert.Equal(t, size, meta[7], name)
+		total += size
+
+		// PageHeader: 1 type (0 is DATA_PAGE), 2 uncompressed_page_size,
+		// 3 compressed_page_size, 5 data_page_header
+		page := &thriftReader{t: t, buf: out[offset : offset+size]}
+		header := page.readStruct()
+		data := page.buf[page.pos:]
+		assert.Equal(t, int64(0), header[1], name)
+		assert.Equal(t, int64(len(data)), header[2], name)
+		assert.Equal(t, int64(len(data)), header[3], name)
+		// DataPageHeader: 1 num_values, 2 encoding, 3 definition and
+		// 4 repetition level encodings
+		assert.Equal(t, map[int16]any{1: int64(3), 2: int64(0), 3: int64(3), 4: int64(3)}, header[5], name)
+		assert.Equal(t, want[name], parquetValues(t, data, meta[1].(int64), 3), name)
+	}
+	assert.Equal(t, total, rowGroup[2])
+
+	buf.Reset()
+	empty, err := export.FromRows([]row{})
+	require.NoError(t, err)
+	require.NoError(t, empty.WriteParquet(&buf))
+	out = buf.Bytes()
+	assert.Equal(t, "PAR1", string(out[:4]))
+	footerLength = int(binary.LittleEndian.Uint32(out[len(out)-8:]))
+	metadata = (&thriftReader{t: t, buf: out[len(out)-8-footerLength : len(out)-8]}).readStruct()
+	assert.Equal(t, int64(0), metadata[3])
+	assert.Empty(t, metadata[4])
+}
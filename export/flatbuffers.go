@@ -0,0 +1,161 @@
+package export
+
+import (
+	"cmp"
+	"encoding/binary"
+	"slices"
+)
+
+// The Arrow IPC metadata is FlatBuffers encoded. The types below describe
+// just enough of a FlatBuffer to write the Schema and RecordBatch messages.
+// Objects are written front to back: a table is followed by the objects it
+// references, so every reference is a forward, unsigned offset.
+
+// fbTable is a table; fields are indexed by their id in the schema. Absent
+// fields are nil.
+type fbTable []any
+
+// fbScalar is the little endian bytes of an inline scalar of 1, 2, 4 or 8
+// bytes.
+type fbScalar []byte
+
+// fbString is a string.
+type fbString string
+
+// fbTables is a vector of tables.
+type fbTables []fbTable
+
+// fbStructs is a vector of structs with 8 byte alignment.
+type fbStructs struct {
+	count int
+	data  []byte
+}
+
+func fbBool(b bool) fbScalar {
+	if b {
+		return fbScalar{1}
+	}
+	return fbScalar{0}
+}
+
+func fbUint8(v uint8) fbScalar { return fbScalar{v} }
+
+func fbInt16(v int16) fbScalar { return binary.LittleEndian.AppendUint16(nil, uint16(v)) }
+
+func fbInt32(v int32) fbScalar { return binary.LittleEndian.AppendUint32(nil, uint32(v)) }
+
+func fbInt64(v int64) fbScalar { return binary.LittleEndian.AppendUint64(nil, uint64(v)) }
+
+// encodeFlatBuffer returns the buffer with root as its root table.
+func encodeFlatBuffer(root fbTable) []byte {
+	w := &fbWriter{buf: make([]byte, 4)}
+	rootPosition := w.write(root)
+	binary.LittleEndian.PutUint32(w.buf, uint32(rootPosition))
+	return w.buf
+}
+
+type fbWriter struct {
+	buf []byte
+}
+
+func (w *fbWriter) pad(align int) {
+	for len(w.buf)%align != 0 {
+		w.buf = append(w.buf, 0)
+	}
+}
+
+// write writes an object and returns the position references point to.
+func (w *fbWriter) write(object any) int {
+	switch o := object.(type) {
+	case fbTable:
+		return w.writeTable(o)
+	case fbString:
+		w.pad(4)
+		position := len(w.buf)
+		w.buf = binary.LittleEndian.AppendUint32(w.buf, uint32(len(o)))
+		w.buf = append(w.buf, o...)
+		w.buf = append(w.buf, 0)
+		return position
+	case fbTables:
+		w.pad(4)
+		position := len(w.buf)
+		w.buf = binary.LittleEndian.AppendUint32(w.buf, uint32(len(o)))
+		references := len(w.buf)
+		w.buf = append(w.buf, make([]byte, 4*len(o))...)
+		for i, table := range o {
+			w.patch(references+4*i, w.write(table))
+		}
+		return position
+	case fbStructs:
+		// the elements after the length are 8 byte aligned
+		for len(w.buf)%8 != 4 {
+			w.buf = append(w.buf, 0)
+		}
+		position := len(w.buf)
+		w.buf = binary.LittleEndian.AppendUint32(w.buf, uint32(o.count))
+		w.buf = append(w.buf, o.data...)
+		return position
+	}
+	panic("unexpected flatbuffer object")
+}
+
+// patch sets the reference at position to target.
+func (w *fbWriter) patch(position, target int) {
+	binary.LittleEndian.PutUint32(w.buf[position:], uint32(target-position))
+}
+
+func (w *fbWriter) writeTable(t fbTable) int {
+	type slot struct {
+		id, size, offset int
+	}
+	var slots []slot
+	for id, field := range t {
+		switch f := field.(type) {
+		case nil:
+		case fbScalar:
+			slots = append(slots, slot{id: id, size: len(f)})
+		default:
+			slots = append(slots, slot{id: id, size: 4})
+		}
+	}
+	// larger fields first keeps every field aligned to its size
+	slices.SortStableFunc(slots, func(a, b slot) int { return cmp.Compare(b.size, a.size) })
+	size, align := 4, 4
+	for i := range slots {
+		for size%slots[i].size != 0 {
+			size++
+		}
+		slots[i].offset = size
+		size += slots[i].size
+		align = max(align, slots[i].size)
+	}
+
+	// vtable: its size, the table size and the offset of each field
+	w.pad(2)
+	vtable := len(w.buf)
+	offsets := make([]uint16, len(t))
+	for _, s := range slots {
+		offsets[s.id] = uint16(s.offset)
+	}
+	w.buf = binary.LittleEndian.AppendUint16(w.buf, uint16(4+2*len(t)))
+	w.buf = binary.LittleEndian.AppendUint16(w.buf, uint16(size))
+	for _, offset := range offsets {
+		w.buf = binary.LittleEndian.AppendUint16(w.buf, offset)
+	}
+
+	w.pad(align)
+	table := len(w.buf)
+	w.buf = append(w.buf, make([]byte, size)...)
+	binary.LittleEndian.PutUint32(w.buf[table:], uint32(int32(table-vtable)))
+	for _, s := range slots {
+		if scalar, ok := t[s.id].(fbScalar); ok {
+			copy(w.buf[table+s.offset:], scalar)
+		}
+	}
+	for _, s := range slots {
+		if _, ok := t[s.id].(fbScalar); !ok {
+			w.patch(table+s.offset, w.write(t[s.id]))
+		}
+	}
+	return table
+}
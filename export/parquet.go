@@ -0,0 +1,245 @@
+package export
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// Parquet constants from parquet.thrift.
+const (
+	parquetBoolean   = 0
+	parquetInt32     = 1
+	parquetInt64     = 2
+	parquetDouble    = 5
+	parquetByteArray = 6
+
+	parquetOptional = 1
+
+	parquetConvertedUTF8            = 0
+	parquetConvertedDate            = 6
+	parquetConvertedTimestampMillis = 9
+	parquetConvertedTimestampMicros = 10
+
+	parquetEncodingPlain = 0
+	parquetEncodingRLE   = 3
+
+	parquetDataPage = 0
+)
+
+const parquetMagic = "PAR1"
+
+// WriteParquet writes the table as an uncompressed Parquet file with one row
+// group. Every column is optional and has one PLAIN encoded data page.
+// Timestamps in seconds are written in milliseconds, as Parquet has no
+// seconds unit, and durations are plain INT64 nanoseconds.
+func (t *Table) WriteParquet(w io.Writer) error {
+	offset := int64(len(parquetMagic))
+	if _, err := io.WriteString(w, parquetMagic); err != nil {
+		return err
+	}
+	chunks := make([]parquetChunk, len(t.Columns))
+	for i, c := range t.Columns {
+		page := t.parquetPage(c)
+		header := parquetPageHeader(t.Rows, len(page))
+		chunks[i] = parquetChunk{offset: offset, size: int64(len(header) + len(page))}
+		for _, b := range [][]byte{header, page} {
+			if _, err := w.Write(b); err != nil {
+				return err
+			}
+		}
+		offset += chunks[i].size
+	}
+	footer := t.parquetFileMetaData(chunks)
+	footer = binary.LittleEndian.AppendUint32(footer, uint32(len(footer)))
+	footer = append(footer, parquetMagic...)
+	_, err := w.Write(footer)
+	return err
+}
+
+type parquetChunk struct {
+	offset, size int64
+}
+
+// parquetPage returns the data of the page of c: the definition levels and
+// the PLAIN encoded values that are not null.
+func (t *Table) parquetPage(c *Column) []byte {
+	// A definition level is 1 for a value and 0 for a null; as one bit
+	// packed run of the RLE/bit-packing hybrid encoding they are the
+	// validity bitmap.
+	var levels []byte
+	if t.Rows > 0 {
+		levels = binary.AppendUvarint(levels, uint64(len(c.Valid))<<1|1)
+		levels = append(levels, c.Valid...)
+	}
+	page := binary.LittleEndian.AppendUint32(nil, uint32(len(levels)))
+	page = append(page, levels...)
+
+	switch c.Type {
+	case Bool:
+		var values Bitmap
+		n := 0
+		for i, b := range c.Bools {
+			if c.Valid.Valid(i) {
+				values.append(n, b)
+				n++
+			}
+		}
+		page = append(page, values...)
+	case Float64:
+		for i, f := range c.Float64s {
+			if c.Valid.Valid(i) {
+				page = binary.LittleEndian.AppendUint64(page, math.Float64bits(f))
+			}
+		}
+	case String:
+		for i, s := range c.Strings {
+			if c.Valid.Valid(i) {
+				page = binary.LittleEndian.AppendUint32(page, uint32(len(s)))
+				page = append(page, s...)
+			}
+		}
+	case Date:
+		for i, v := range c.Int32s {
+			if c.Valid.Valid(i) {
+				page = binary.LittleEndian.AppendUint32(page, uint32(v))
+			}
+		}
+	default:
+		scale := int64(1)
+		if c.Type == Timestamp && c.Unit == Second {
+			scale = 1000
+		}
+		for i, v := range c.Int64s {
+			if c.Valid.Valid(i) {
+				page = binary.LittleEndian.AppendUint64(page, uint64(v*scale))
+			}
+		}
+	}
+	return page
+}
+
+func parquetPageHeader(rows, size int) []byte {
+	var w thriftWriter
+	w.beginStruct()
+	w.i32Field(1, parquetDataPage)
+	w.i32Field(2, int32(size))
+	w.i32Field(3, int32(size))
+	w.structField(5)
+	w.i32Field(1, int32(rows))
+	w.i32Field(2, parquetEncodingPlain)
+	w.i32Field(3, parquetEncodingRLE)
+	w.i32Field(4, parquetEncodingRLE)
+	w.endStruct()
+	w.endStruct()
+	return w.buf
+}
+
+func (t *Table) parquetFileMetaData(chunks []parquetChunk) []byte {
+	var w thriftWriter
+	w.beginStruct()
+	w.i32Field(1, 1)
+
+	w.listField(2, thriftStruct, len(t.Columns)+1)
+	w.beginStruct()
+	w.stringField(4, "schema")
+	w.i32Field(5, int32(len(t.Columns)))
+	w.endStruct()
+	for _, c := range t.Columns {
+		writeParquetSchemaElement(&w, c)
+	}
+
+	w.i64Field(3, int64(t.Rows))
+	if t.Rows == 0 {
+		w.listField(4, thriftStruct, 0)
+		w.endStruct()
+		return w.buf
+	}
+	w.listField(4, thriftStruct, 1)
+	w.beginStruct()
+	w.listField(1, thriftStruct, len(t.Columns))
+	var total int64
+	for i, c := range t.Columns {
+		chunk := chunks[i]
+		total += chunk.size
+		w.beginStruct()
+		w.i64Field(2, chunk.offset)
+		w.structField(3)
+		w.i32Field(1, parquetPhysicalType(c))
+		w.listField(2, thriftI32, 2)
+		w.i32(parquetEncodingPlain)
+		w.i32(parquetEncodingRLE)
+		w.listField(3, thriftBinary, 1)
+		w.string(c.Name)
+		w.i32Field(4, 0) // uncompressed
+		w.i64Field(5, int64(t.Rows))
+		w.i64Field(6, chunk.size)
+		w.i64Field(7, chunk.size)
+		w.i64Field(9, chunk.offset)
+		w.endStruct()
+		w.endStruct()
+	}
+	w.i64Field(2, total)
+	w.i64Field(3, int64(t.Rows))
+	w.endStruct()
+	w.endStruct()
+	return w.buf
+}
+
+func parquetPhysicalType(c *Column) int32 {
+	switch c.Type {
+	case Bool:
+		return parquetBoolean
+	case Float64:
+		return parquetDouble
+	case String:
+		return parquetByteArray
+	case Date:
+		return parquetInt32
+	default:
+		return parquetInt64
+	}
+}
+
+func writeParquetSchemaElement(w *thriftWriter, c *Column) {
+	w.beginStruct()
+	w.i32Field(1, parquetPhysicalType(c))
+	w.i32Field(3, parquetOptional)
+	w.stringField(4, c.Name)
+	switch c.Type {
+	case String:
+		w.i32Field(6, parquetConvertedUTF8)
+		w.structField(10)
+		w.structField(1) // STRING
+		w.endStruct()
+		w.endStruct()
+	case Date:
+		w.i32Field(6, parquetConvertedDate)
+		w.structField(10)
+		w.structField(6) // DATE
+		w.endStruct()
+		w.endStruct()
+	case Timestamp:
+		// the TimeUnit union: MILLIS, MICROS or NANOS
+		unit := int16(1)
+		switch c.Unit {
+		case Microsecond:
+			unit = 2
+			w.i32Field(6, parquetConvertedTimestampMicros)
+		case Nanosecond:
+			unit = 3
+		default:
+			w.i32Field(6, parquetConvertedTimestampMillis)
+		}
+		w.structField(10)
+		w.structField(8) // TIMESTAMP
+		w.boolField(1, true)
+		w.structField(2)
+		w.structField(unit)
+		w.endStruct()
+		w.endStruct()
+		w.endStruct()
+		w.endStruct()
+	}
+	w.endStruct()
+}
@@ -0,0 +1,400 @@
+package export_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// The readers below decode the Arrow IPC stream and Parquet file formats
+// from their specifications, independently of the writers, so the tests
+// check what another reader would see.
+
+// flatTable is a FlatBuffers table; see
+// https://flatbuffers.dev/internals/.
+type flatTable struct {
+	buf []byte
+	pos int
+}
+
+func flatRoot(buf []byte) flatTable {
+	return flatTable{buf: buf, pos: int(binary.LittleEndian.Uint32(buf))}
+}
+
+// field returns the position of the field with id or 0 when it is absent.
+func (t flatTable) field(id int) int {
+	vtable := t.pos - int(int32(binary.LittleEndian.Uint32(t.buf[t.pos:])))
+	entry := 4 + 2*id
+	if entry >= int(binary.LittleEndian.Uint16(t.buf[vtable:])) {
+		return 0
+	}
+	offset := int(binary.LittleEndian.Uint16(t.buf[vtable+entry:]))
+	if offset == 0 {
+		return 0
+	}
+	return t.pos + offset
+}
+
+func (t flatTable) uint8(id int, fallback uint8) uint8 {
+	if p := t.field(id); p != 0 {
+		return t.buf[p]
+	}
+	return fallback
+}
+
+func (t flatTable) int16(id int, fallback int16) int16 {
+	if p := t.field(id); p != 0 {
+		return int16(binary.LittleEndian.Uint16(t.buf[p:]))
+	}
+	return fallback
+}
+
+func (t flatTable) int32(id int, fallback int32) int32 {
+	if p := t.field(id); p != 0 {
+		return int32(binary.LittleEndian.Uint32(t.buf[p:]))
+	}
+	return fallback
+}
+
+func (t flatTable) int64(id int, fallback int64) int64 {
+	if p := t.field(id); p != 0 {
+		return int64(binary.LittleEndian.Uint64(t.buf[p:]))
+	}
+	return fallback
+}
+
+func (t flatTable) bool(id int, fallback bool) bool {
+	if p := t.field(id); p != 0 {
+		return t.buf[p] != 0
+	}
+	return fallback
+}
+
+// reference returns the position of the object a reference field points to
+// or 0 when it is absent.
+func (t flatTable) reference(id int) int {
+	p := t.field(id)
+	if p == 0 {
+		return 0
+	}
+	return p + int(binary.LittleEndian.Uint32(t.buf[p:]))
+}
+
+func (t flatTable) string(id int) string {
+	p := t.reference(id)
+	if p == 0 {
+		return ""
+	}
+	n := int(binary.LittleEndian.Uint32(t.buf[p:]))
+	return string(t.buf[p+4 : p+4+n])
+}
+
+func (t flatTable) table(id int) (flatTable, bool) {
+	p := t.reference(id)
+	return flatTable{buf: t.buf, pos: p}, p != 0
+}
+
+func (t flatTable) tables(id int) []flatTable {
+	p := t.reference(id)
+	if p == 0 {
+		return nil
+	}
+	tables := make([]flatTable, binary.LittleEndian.Uint32(t.buf[p:]))
+	for i := range tables {
+		element := p + 4 + 4*i
+		tables[i] = flatTable{buf: t.buf, pos: element + int(binary.LittleEndian.Uint32(t.buf[element:]))}
+	}
+	return tables
+}
+
+// int64Structs returns a vector of structs with fields int64 fields each.
+func (t flatTable) int64Structs(id, fields int) [][]int64 {
+	p := t.reference(id)
+	if p == 0 {
+		return nil
+	}
+	structs := make([][]int64, binary.LittleEndian.Uint32(t.buf[p:]))
+	for i := range structs {
+		for j := range fields {
+			structs[i] = append(structs[i], int64(binary.LittleEndian.Uint64(t.buf[p+4+8*(i*fields+j):])))
+		}
+	}
+	return structs
+}
+
+type arrowMessage struct {
+	headerType uint8
+	header     flatTable
+	body       []byte
+}
+
+// readArrowStream returns the messages of an IPC stream up to the end of
+// stream marker, which must end out.
+func readArrowStream(t *testing.T, out []byte) []arrowMessage {
+	t.Helper()
+	var messages []arrowMessage
+	for offset := 0; ; {
+		if binary.LittleEndian.Uint32(out[offset:]) != 0xFFFFFFFF {
+			t.Fatalf("message at %d has no continuation marker", offset)
+		}
+		length := int(binary.LittleEndian.Uint32(out[offset+4:]))
+		if length == 0 {
+			if offset+8 != len(out) {
+				t.Fatalf("%d bytes after the end of stream marker", len(out)-offset-8)
+			}
+			return messages
+		}
+		if length%8 != 0 {
+			t.Fatalf("message metadata of %d bytes is not padded to 8 bytes", length)
+		}
+		message := flatRoot(out[offset+8 : offset+8+length])
+		if version := message.int16(0, 0); version != 4 {
+			t.Fatalf("metadata version %d, expected V5", version)
+		}
+		header, ok := message.table(2)
+		if !ok {
+			t.Fatal("message has no header")
+		}
+		start := offset + 8 + length
+		bodyLength := int(message.int64(3, 0))
+		messages = append(messages, arrowMessage{
+			headerType: message.uint8(1, 0),
+			header:     header,
+			body:       out[start : start+bodyLength],
+		})
+		offset = start + bodyLength
+	}
+}
+
+var arrowUnits = []string{"s", "ms", "us", "ns"}
+
+// arrowTypeString describes the Type union of a Field table.
+func arrowTypeString(field flatTable) string {
+	typ, _ := field.table(3)
+	switch typeType := field.uint8(2, 0); typeType {
+	case 2:
+		return fmt.Sprintf("int%d signed=%t", typ.int32(0, 0), typ.bool(1, false))
+	case 3:
+		return []string{"half", "float", "double"}[typ.int16(0, 0)]
+	case 5:
+		return "utf8"
+	case 6:
+		return "bool"
+	case 8:
+		return []string{"date32[day]", "date64[ms]"}[typ.int16(0, 1)]
+	case 10:
+		if tz := typ.string(1); tz != "" {
+			return fmt.Sprintf("timestamp[%s, %s]", arrowUnits[typ.int16(0, 0)], tz)
+		}
+		return fmt.Sprintf("timestamp[%s]", arrowUnits[typ.int16(0, 0)])
+	case 18:
+		return fmt.Sprintf("duration[%s]", arrowUnits[typ.int16(0, 1)])
+	default:
+		return fmt.Sprintf("type %d", typeType)
+	}
+}
+
+// arrowValues decodes the buffers of a column of the type typeType with
+// length values; nulls are nil. It returns the remaining buffers.
+func arrowValues(t *testing.T, body []byte, buffers [][]int64, typeType uint8, length, nullCount int) ([]any, [][]int64) {
+	t.Helper()
+	buffer := func() []byte {
+		if len(buffers) == 0 {
+			t.Fatal("missing buffer")
+		}
+		offset, size := buffers[0][0], buffers[0][1]
+		if offset%8 != 0 || offset+size > int64(len(body)) {
+			t.Fatalf("buffer at %d of %d bytes is not aligned in the body of %d bytes", offset, size, len(body))
+		}
+		buffers = buffers[1:]
+		return body[offset : offset+size]
+	}
+	bit := func(b []byte, i int) bool { return b[i/8]&(1<<(i%8)) != 0 }
+
+	validity := buffer()
+	if len(validity) != 0 && len(validity) < (length+7)/8 {
+		t.Fatalf("validity buffer of %d bytes for %d values", len(validity), length)
+	}
+	var offsets []byte
+	if typeType == 5 {
+		offsets = buffer()
+	}
+	data := buffer()
+	values := make([]any, length)
+	nulls := 0
+	for i := range values {
+		if len(validity) > 0 && !bit(validity, i) {
+			nulls++
+			continue
+		}
+		switch typeType {
+		case 3:
+			values[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		case 5:
+			start, end := binary.LittleEndian.Uint32(offsets[4*i:]), binary.LittleEndian.Uint32(offsets[4*i+4:])
+			values[i] = string(data[start:end])
+		case 6:
+			values[i] = bit(data, i)
+		case 8:
+			values[i] = int32(binary.LittleEndian.Uint32(data[4*i:]))
+		default:
+			values[i] = int64(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	if nulls != nullCount {
+		t.Fatalf("%d nulls in the validity buffer, the field node has %d", nulls, nullCount)
+	}
+	return values, buffers
+}
+
+// thriftReader decodes the Thrift compact protocol; see
+// https://github.com/apache/thrift/blob/master/doc/specs/thrift-compact-protocol.md.
+// Structs are maps of field ids to values, lists are slices, integers are
+// int64, binary is string and booleans are bool.
+type thriftReader struct {
+	t   *testing.T
+	buf []byte
+	pos int
+}
+
+func (r *thriftReader) byte() byte {
+	if r.pos >= len(r.buf) {
+		r.t.Fatal("unexpected end of thrift data")
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *thriftReader) varint() int64 {
+	v, n := binary.Varint(r.buf[r.pos:])
+	if n <= 0 {
+		r.t.Fatal("invalid thrift varint")
+	}
+	r.pos += n
+	return v
+}
+
+func (r *thriftReader) uvarint() uint64 {
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	if n <= 0 {
+		r.t.Fatal("invalid thrift varint")
+	}
+	r.pos += n
+	return v
+}
+
+func (r *thriftReader) readStruct() map[int16]any {
+	fields := make(map[int16]any)
+	var id int16
+	for {
+		header := r.byte()
+		if header == 0 {
+			return fields
+		}
+		if delta := int16(header >> 4); delta != 0 {
+			id += delta
+		} else {
+			id = int16(r.varint())
+		}
+		switch fieldType := header & 0x0F; fieldType {
+		case 1, 2:
+			fields[id] = fieldType == 1
+		default:
+			fields[id] = r.value(fieldType)
+		}
+	}
+}
+
+func (r *thriftReader) value(valueType byte) any {
+	switch valueType {
+	case 5, 6:
+		return r.varint()
+	case 8:
+		n := int(r.uvarint())
+		s := string(r.buf[r.pos : r.pos+n])
+		r.pos += n
+		return s
+	case 9:
+		header := r.byte()
+		n := int(header >> 4)
+		if n == 15 {
+			n = int(r.uvarint())
+		}
+		list := make([]any, n)
+		for i := range list {
+			list[i] = r.value(header & 0x0F)
+		}
+		return list
+	case 12:
+		return r.readStruct()
+	}
+	r.t.Fatalf("unexpected thrift type %d", valueType)
+	return nil
+}
+
+// parquetValues decodes a data page of an optional column with the
+// physical type and rows values; nulls are nil.
+func parquetValues(t *testing.T, page []byte, physicalType int64, rows int) []any {
+	t.Helper()
+	// definition levels: a 4 byte length and RLE/bit-packed hybrid runs of
+	// 1 bit levels
+	n := int(binary.LittleEndian.Uint32(page))
+	r := &thriftReader{t: t, buf: page[4 : 4+n]}
+	var levels []bool
+	for r.pos < len(r.buf) {
+		header := r.uvarint()
+		if header&1 == 1 {
+			// groups of 8 levels, one byte each
+			groups := int(header >> 1)
+			for i := range 8 * groups {
+				levels = append(levels, r.buf[r.pos+i/8]&(1<<(i%8)) != 0)
+			}
+			r.pos += groups
+		} else {
+			value := r.byte() != 0
+			for range int(header >> 1) {
+				levels = append(levels, value)
+			}
+		}
+	}
+	if len(levels) < rows {
+		t.Fatalf("%d definition levels for %d rows", len(levels), rows)
+	}
+	data := page[4+n:]
+	values := make([]any, rows)
+	bits := 0
+	for i := range values {
+		if !levels[i] {
+			continue
+		}
+		switch physicalType {
+		case 0:
+			values[i] = data[bits/8]&(1<<(bits%8)) != 0
+			bits++
+		case 1:
+			values[i] = int32(binary.LittleEndian.Uint32(data))
+			data = data[4:]
+		case 2:
+			values[i] = int64(binary.LittleEndian.Uint64(data))
+			data = data[8:]
+		case 5:
+			values[i] = math.Float64frombits(binary.LittleEndian.Uint64(data))
+			data = data[8:]
+		case 6:
+			size := binary.LittleEndian.Uint32(data)
+			values[i] = string(data[4 : 4+size])
+			data = data[4+size:]
+		default:
+			t.Fatalf("unexpected physical type %d", physicalType)
+		}
+	}
+	if physicalType == 0 {
+		data = data[(bits+7)/8:]
+	}
+	if len(data) != 0 {
+		t.Fatalf("%d bytes after the values", len(data))
+	}
+	return values
+}
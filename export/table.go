@@ -0,0 +1,406 @@
+// Package export converts rows tagged for api.ParseCSV into columns and
+// writes them in formats columnar engines load: Arrow IPC streams and
+// Parquet files.
+//
+// Columns are named by the `column-name` tags. Time fields are dates when
+// their `time-layout` has no time of day and timestamps otherwise, in the
+// unit of the most precise layout: "2006-01-02 15:04:05" gives seconds and
+// "2006-01-02 15:04:05.000" milliseconds. A `time-unit:"date|s|ms|us|ns"`
+// tag sets the unit explicitly.
+//
+// Values the api package treats as missing, NaN floats, nil pointers and
+// zero times, are null.
+package export
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Type is the type of the values of a Column.
+type Type int
+
+const (
+	// Bool values are in Column.Bools.
+	Bool Type = iota
+	// Int64 values are in Column.Int64s.
+	Int64
+	// Float64 values are in Column.Float64s.
+	Float64
+	// String values are in Column.Strings.
+	String
+	// Date values are days since 1970-01-01 in Column.Int32s.
+	Date
+	// Timestamp values are Column.Unit intervals since the Unix epoch in
+	// Column.Int64s.
+	Timestamp
+	// Duration values are nanoseconds in Column.Int64s.
+	Duration
+)
+
+func (t Type) String() string {
+	switch t {
+	case Bool:
+		return "bool"
+	case Int64:
+		return "int64"
+	case Float64:
+		return "float64"
+	case String:
+		return "string"
+	case Date:
+		return "date"
+	case Timestamp:
+		return "timestamp"
+	case Duration:
+		return "duration"
+	default:
+		return fmt.Sprintf("Type(%d)", int(t))
+	}
+}
+
+// TimeUnit is the unit of Timestamp values.
+type TimeUnit int
+
+const (
+	Second TimeUnit = iota
+	Millisecond
+	Microsecond
+	Nanosecond
+)
+
+// Duration returns the length of the unit.
+func (u TimeUnit) Duration() time.Duration {
+	switch u {
+	case Millisecond:
+		return time.Millisecond
+	case Microsecond:
+		return time.Microsecond
+	case Nanosecond:
+		return time.Nanosecond
+	default:
+		return time.Second
+	}
+}
+
+// Bitmap is a validity bitmap: bit i, least significant bit first, is set
+// when value i is present. It is the layout of Arrow validity buffers and of
+// Parquet definition levels for flat columns.
+type Bitmap []byte
+
+// Valid reports whether value i is present.
+func (b Bitmap) Valid(i int) bool {
+	return b[i/8]&(1<<(i%8)) != 0
+}
+
+func (b *Bitmap) append(n int, valid bool) {
+	if n%8 == 0 {
+		*b = append(*b, 0)
+	}
+	if valid {
+		(*b)[n/8] |= 1 << (n % 8)
+	}
+}
+
+// Column is the values of one field of the rows. Only the slice of its Type
+// is set; null rows hold the zero value.
+type Column struct {
+	Name string
+	Type Type
+	// Unit is the unit of Timestamp values.
+	Unit TimeUnit
+	// TimeZone is the location name of the first Timestamp value. Values
+	// are instants, so it only tells readers how to display them.
+	TimeZone string
+	// Valid has a bit per row.
+	Valid     Bitmap
+	NullCount int
+
+	Bools    []bool
+	Int32s   []int32
+	Int64s   []int64
+	Float64s []float64
+	Strings  []string
+}
+
+// Table is rows stored as columns.
+type Table struct {
+	Columns []*Column
+	Rows    int
+}
+
+// Builder appends rows of type T to a Table.
+type Builder[T any] struct {
+	table   *Table
+	columns []builderColumn
+}
+
+type builderColumn struct {
+	index  int
+	column *Column
+	append func(c *Column, v reflect.Value) error
+}
+
+// NewBuilder returns a Builder for the fields of T with a column-name tag.
+func NewBuilder[T any]() (*Builder[T], error) {
+	structType := reflect.TypeFor[T]()
+	if structType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("row type %s is not a struct", structType)
+	}
+	b := &Builder[T]{table: new(Table)}
+	for i := range structType.NumField() {
+		field := structType.Field(i)
+		name := field.Tag.Get("column-name")
+		if name == "" {
+			continue
+		}
+		column := &Column{Name: name}
+		appendValue, err := newAppender(column, field.Type, field)
+		if err != nil {
+			return nil, err
+		}
+		b.table.Columns = append(b.table.Columns, column)
+		b.columns = append(b.columns, builderColumn{index: i, column: column, append: appendValue})
+	}
+	return b, nil
+}
+
+// Append adds row to the table.
+func (b *Builder[T]) Append(row T) error {
+	v := reflect.ValueOf(row)
+	for _, c := range b.columns {
+		if err := c.append(c.column, v.Field(c.index)); err != nil {
+			return fmt.Errorf("failed to export row %d column %s: %w", b.table.Rows+1, c.column.Name, err)
+		}
+	}
+	b.table.Rows++
+	return nil
+}
+
+// Table returns the table of the rows appended so far.
+func (b *Builder[T]) Table() *Table {
+	return b.table
+}
+
+// FromRows returns a table of rows.
+func FromRows[T any](rows []T) (*Table, error) {
+	b, err := NewBuilder[T]()
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if err := b.Append(row); err != nil {
+			return nil, err
+		}
+	}
+	return b.Table(), nil
+}
+
+// FromSeq returns a table of the rows of an iterator like api.Rows. It stops
+// at the first error.
+func FromSeq[T any](rows iter.Seq2[T, error]) (*Table, error) {
+	b, err := NewBuilder[T]()
+	if err != nil {
+		return nil, err
+	}
+	for row, err := range rows {
+		if err != nil {
+			return nil, err
+		}
+		if err := b.Append(row); err != nil {
+			return nil, err
+		}
+	}
+	return b.Table(), nil
+}
+
+// appendNull appends a null to c.
+func appendNull(c *Column, n int) {
+	c.Valid.append(n, false)
+	c.NullCount++
+	switch c.Type {
+	case Bool:
+		c.Bools = append(c.Bools, false)
+	case Date:
+		c.Int32s = append(c.Int32s, 0)
+	case Int64, Timestamp, Duration:
+		c.Int64s = append(c.Int64s, 0)
+	case Float64:
+		c.Float64s = append(c.Float64s, 0)
+	case String:
+		c.Strings = append(c.Strings, "")
+	}
+}
+
+// rowCount returns the number of values in c.
+func rowCount(c *Column) int {
+	return len(c.Bools) + len(c.Int32s) + len(c.Int64s) + len(c.Float64s) + len(c.Strings)
+}
+
+var (
+	timeType     = reflect.TypeFor[time.Time]()
+	durationType = reflect.TypeFor[time.Duration]()
+)
+
+func newAppender(c *Column, t reflect.Type, field reflect.StructField) (func(*Column, reflect.Value) error, error) {
+	if t.Kind() == reflect.Pointer {
+		elem, err := newAppender(c, t.Elem(), field)
+		if err != nil {
+			return nil, err
+		}
+		return func(c *Column, v reflect.Value) error {
+			if v.IsNil() {
+				appendNull(c, rowCount(c))
+				return nil
+			}
+			return elem(c, v.Elem())
+		}, nil
+	}
+
+	switch t {
+	case timeType:
+		unit, date, err := timeUnit(field)
+		if err != nil {
+			return nil, err
+		}
+		if date {
+			c.Type = Date
+			return func(c *Column, v reflect.Value) error {
+				n := len(c.Int32s)
+				tm := v.Interface().(time.Time)
+				if tm.IsZero() {
+					appendNull(c, n)
+					return nil
+				}
+				day := time.Date(tm.Year(), tm.Month(), tm.Day(), 0, 0, 0, 0, time.UTC)
+				c.Valid.append(n, true)
+				c.Int32s = append(c.Int32s, int32(day.Unix()/(24*60*60)))
+				return nil
+			}, nil
+		}
+		c.Type, c.Unit = Timestamp, unit
+		return func(c *Column, v reflect.Value) error {
+			n := len(c.Int64s)
+			tm := v.Interface().(time.Time)
+			if tm.IsZero() {
+				appendNull(c, n)
+				return nil
+			}
+			if c.TimeZone == "" {
+				c.TimeZone = tm.Location().String()
+			}
+			c.Valid.append(n, true)
+			c.Int64s = append(c.Int64s, timestampValue(tm, c.Unit))
+			return nil
+		}, nil
+	case durationType:
+		c.Type = Duration
+		return func(c *Column, v reflect.Value) error {
+			c.Valid.append(len(c.Int64s), true)
+			c.Int64s = append(c.Int64s, v.Int())
+			return nil
+		}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		c.Type = Bool
+		return func(c *Column, v reflect.Value) error {
+			c.Valid.append(len(c.Bools), true)
+			c.Bools = append(c.Bools, v.Bool())
+			return nil
+		}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		c.Type = Int64
+		return func(c *Column, v reflect.Value) error {
+			c.Valid.append(len(c.Int64s), true)
+			c.Int64s = append(c.Int64s, v.Int())
+			return nil
+		}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		c.Type = Int64
+		return func(c *Column, v reflect.Value) error {
+			u := v.Uint()
+			if u > math.MaxInt64 {
+				return fmt.Errorf("value %d overflows int64", u)
+			}
+			c.Valid.append(len(c.Int64s), true)
+			c.Int64s = append(c.Int64s, int64(u))
+			return nil
+		}, nil
+	case reflect.Float32, reflect.Float64:
+		c.Type = Float64
+		return func(c *Column, v reflect.Value) error {
+			n := len(c.Float64s)
+			f := v.Float()
+			if math.IsNaN(f) {
+				appendNull(c, n)
+				return nil
+			}
+			c.Valid.append(n, true)
+			c.Float64s = append(c.Float64s, f)
+			return nil
+		}, nil
+	case reflect.String:
+		c.Type = String
+		return func(c *Column, v reflect.Value) error {
+			c.Valid.append(len(c.Strings), true)
+			c.Strings = append(c.Strings, v.String())
+			return nil
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s for field %s", t, field.Name)
+}
+
+// timeUnit returns the unit of a time field from its time-unit tag or the
+// most precise of its time-layout layouts.
+func timeUnit(field reflect.StructField) (unit TimeUnit, date bool, err error) {
+	if tag, ok := field.Tag.Lookup("time-unit"); ok {
+		switch tag {
+		case "date":
+			return 0, true, nil
+		case "s":
+			return Second, false, nil
+		case "ms":
+			return Millisecond, false, nil
+		case "us":
+			return Microsecond, false, nil
+		case "ns":
+			return Nanosecond, false, nil
+		}
+		return 0, false, fmt.Errorf("unknown time-unit %q for field %s", tag, field.Name)
+	}
+	date = true
+	for layout := range strings.SplitSeq(cmp.Or(field.Tag.Get("time-layout"), "2006-01-02"), "|") {
+		switch {
+		case strings.Contains(layout, ".000000000") || strings.Contains(layout, ".999999999"):
+			unit = max(unit, Nanosecond)
+		case strings.Contains(layout, ".000000") || strings.Contains(layout, ".999999"):
+			unit = max(unit, Microsecond)
+		case strings.Contains(layout, ".000") || strings.Contains(layout, ".999"):
+			unit = max(unit, Millisecond)
+		}
+		if strings.Contains(layout, "15") || strings.Contains(layout, "03") || strings.Contains(layout, "04") {
+			date = false
+		}
+	}
+	return unit, date, nil
+}
+
+func timestampValue(tm time.Time, unit TimeUnit) int64 {
+	switch unit {
+	case Millisecond:
+		return tm.UnixMilli()
+	case Microsecond:
+		return tm.UnixMicro()
+	case Nanosecond:
+		return tm.UnixNano()
+	default:
+		return tm.Unix()
+	}
+}
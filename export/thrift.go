@@ -0,0 +1,94 @@
+package export
+
+import "encoding/binary"
+
+// Parquet metadata is encoded with the Thrift compact protocol. thriftWriter
+// writes just the types Parquet file metadata uses.
+
+// Thrift compact protocol field types.
+const (
+	thriftTrue   = 1
+	thriftFalse  = 2
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+type thriftWriter struct {
+	buf []byte
+	// last holds the id of the previous field of each open struct.
+	last []int16
+}
+
+func (w *thriftWriter) fieldHeader(id int16, fieldType byte) {
+	last := &w.last[len(w.last)-1]
+	if delta := id - *last; 0 < delta && delta <= 15 {
+		w.buf = append(w.buf, byte(delta)<<4|fieldType)
+	} else {
+		w.buf = append(w.buf, fieldType)
+		w.buf = binary.AppendVarint(w.buf, int64(id))
+	}
+	*last = id
+}
+
+// beginStruct starts a struct value; the root struct has no field header.
+func (w *thriftWriter) beginStruct() {
+	w.last = append(w.last, 0)
+}
+
+func (w *thriftWriter) endStruct() {
+	w.buf = append(w.buf, 0)
+	w.last = w.last[:len(w.last)-1]
+}
+
+func (w *thriftWriter) structField(id int16) {
+	w.fieldHeader(id, thriftStruct)
+	w.beginStruct()
+}
+
+func (w *thriftWriter) boolField(id int16, v bool) {
+	if v {
+		w.fieldHeader(id, thriftTrue)
+	} else {
+		w.fieldHeader(id, thriftFalse)
+	}
+}
+
+func (w *thriftWriter) i32Field(id int16, v int32) {
+	w.fieldHeader(id, thriftI32)
+	w.buf = binary.AppendVarint(w.buf, int64(v))
+}
+
+func (w *thriftWriter) i64Field(id int16, v int64) {
+	w.fieldHeader(id, thriftI64)
+	w.buf = binary.AppendVarint(w.buf, v)
+}
+
+func (w *thriftWriter) stringField(id int16, v string) {
+	w.fieldHeader(id, thriftBinary)
+	w.string(v)
+}
+
+func (w *thriftWriter) string(v string) {
+	w.buf = binary.AppendUvarint(w.buf, uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+// listField starts a list of n elements of elementType. Struct elements
+// are written with beginStruct and endStruct.
+func (w *thriftWriter) listField(id int16, elementType byte, n int) {
+	w.fieldHeader(id, thriftList)
+	if n < 15 {
+		w.buf = append(w.buf, byte(n)<<4|elementType)
+	} else {
+		w.buf = append(w.buf, 0xF0|elementType)
+		w.buf = binary.AppendUvarint(w.buf, uint64(n))
+	}
+}
+
+// i32 writes an i32 list element.
+func (w *thriftWriter) i32(v int32) {
+	w.buf = binary.AppendVarint(w.buf, int64(v))
+}
@@ -4,6 +4,7 @@ package alphavantage
 
 import (
 	"context"
+	"github.com/portfoliotree/alphavantage/api"
 	"github.com/portfoliotree/alphavantage/query/commodities"
 	"github.com/portfoliotree/alphavantage/query/crypto"
 	"github.com/portfoliotree/alphavantage/query/economic"
@@ -12,6 +13,7 @@ import (
 	"github.com/portfoliotree/alphavantage/query/options"
 	"github.com/portfoliotree/alphavantage/query/technical"
 	"github.com/portfoliotree/alphavantage/query/timeseries"
+	"iter"
 )
 
 type CommoditiesFunctions Client
@@ -72,390 +74,1186 @@ func (f *CommoditiesFunctions) All(ctx context.Context, query commodities.AllQue
 	return queryRows[commodities.AllRow](ctx, (*Client)(f), query)
 }
 
+func (f *CommoditiesFunctions) AllSeq(ctx context.Context, query commodities.AllQuery) iter.Seq2[commodities.AllRow, error] {
+	return queryRowsSeq[commodities.AllRow](ctx, (*Client)(f), query)
+}
+
+func (f *CommoditiesFunctions) AllSeries(ctx context.Context, query commodities.AllQuery) (api.Series[commodities.AllRow], error) {
+	return querySeries[commodities.AllRow](ctx, (*Client)(f), query)
+}
+
 func (f *CommoditiesFunctions) Aluminum(ctx context.Context, query commodities.AluminumQuery) ([]commodities.AluminumRow, error) {
 	return queryRows[commodities.AluminumRow](ctx, (*Client)(f), query)
 }
 
+func (f *CommoditiesFunctions) AluminumSeq(ctx context.Context, query commodities.AluminumQuery) iter.Seq2[commodities.AluminumRow, error] {
+	return queryRowsSeq[commodities.AluminumRow](ctx, (*Client)(f), query)
+}
+
+func (f *CommoditiesFunctions) AluminumSeries(ctx context.Context, query commodities.AluminumQuery) (api.Series[commodities.AluminumRow], error) {
+	return querySeries[commodities.AluminumRow](ctx, (*Client)(f), query)
+}
+
 func (f *CommoditiesFunctions) Brent(ctx context.Context, query commodities.BrentQuery) ([]commodities.BrentRow, error) {
 	return queryRows[commodities.BrentRow](ctx, (*Client)(f), query)
 }
 
+func (f *CommoditiesFunctions) BrentSeq(ctx context.Context, query commodities.BrentQuery) iter.Seq2[commodities.BrentRow, error] {
+	return queryRowsSeq[commodities.BrentRow](ctx, (*Client)(f), query)
+}
+
+func (f *CommoditiesFunctions) BrentSeries(ctx context.Context, query commodities.BrentQuery) (api.Series[commodities.BrentRow], error) {
+	return querySeries[commodities.BrentRow](ctx, (*Client)(f), query)
+}
+
 func (f *CommoditiesFunctions) Coffee(ctx context.Context, query commodities.CoffeeQuery) ([]commodities.CoffeeRow, error) {
 	return queryRows[commodities.CoffeeRow](ctx, (*Client)(f), query)
 }
 
+func (f *CommoditiesFunctions) CoffeeSeq(ctx context.Context, query commodities.CoffeeQuery) iter.Seq2[commodities.CoffeeRow, error] {
+	return queryRowsSeq[commodities.CoffeeRow](ctx, (*Client)(f), query)
+}
+
+func (f *CommoditiesFunctions) CoffeeSeries(ctx context.Context, query commodities.CoffeeQuery) (api.Series[commodities.CoffeeRow], error) {
+	return querySeries[commodities.CoffeeRow](ctx, (*Client)(f), query)
+}
+
 func (f *CommoditiesFunctions) Copper(ctx context.Context, query commodities.CopperQuery) ([]commodities.CopperRow, error) {
 	return queryRows[commodities.CopperRow](ctx, (*Client)(f), query)
 }
 
+func (f *CommoditiesFunctions) CopperSeq(ctx context.Context, query commodities.CopperQuery) iter.Seq2[commodities.CopperRow, error] {
+	return queryRowsSeq[commodities.CopperRow](ctx, (*Client)(f), query)
+}
+
+func (f *CommoditiesFunctions) CopperSeries(ctx context.Context, query commodities.CopperQuery) (api.Series[commodities.CopperRow], error) {
+	return querySeries[commodities.CopperRow](ctx, (*Client)(f), query)
+}
+
 func (f *CommoditiesFunctions) Corn(ctx context.Context, query commodities.CornQuery) ([]commodities.CornRow, error) {
 	return queryRows[commodities.CornRow](ctx, (*Client)(f), query)
 }
 
+func (f *CommoditiesFunctions) CornSeq(ctx context.Context, query commodities.CornQuery) iter.Seq2[commodities.CornRow, error] {
+	return queryRowsSeq[commodities.CornRow](ctx, (*Client)(f), query)
+}
+
+func (f *CommoditiesFunctions) CornSeries(ctx context.Context, query commodities.CornQuery) (api.Series[commodities.CornRow], error) {
+	return querySeries[commodities.CornRow](ctx, (*Client)(f), query)
+}
+
 func (f *CommoditiesFunctions) Cotton(ctx context.Context, query commodities.CottonQuery) ([]commodities.CottonRow, error) {
 	return queryRows[commodities.CottonRow](ctx, (*Client)(f), query)
 }
 
+func (f *CommoditiesFunctions) CottonSeq(ctx context.Context, query commodities.CottonQuery) iter.Seq2[commodities.CottonRow, error] {
+	return queryRowsSeq[commodities.CottonRow](ctx, (*Client)(f), query)
+}
+
+func (f *CommoditiesFunctions) CottonSeries(ctx context.Context, query commodities.CottonQuery) (api.Series[commodities.CottonRow], error) {
+	return querySeries[commodities.CottonRow](ctx, (*Client)(f), query)
+}
+
 func (f *CommoditiesFunctions) NaturalGas(ctx context.Context, query commodities.NaturalGasQuery) ([]commodities.NaturalGasRow, error) {
 	return queryRows[commodities.NaturalGasRow](ctx, (*Client)(f), query)
 }
 
+func (f *CommoditiesFunctions) NaturalGasSeq(ctx context.Context, query commodities.NaturalGasQuery) iter.Seq2[commodities.NaturalGasRow, error] {
+	return queryRowsSeq[commodities.NaturalGasRow](ctx, (*Client)(f), query)
+}
+
+func (f *CommoditiesFunctions) NaturalGasSeries(ctx context.Context, query commodities.NaturalGasQuery) (api.Series[commodities.NaturalGasRow], error) {
+	return querySeries[commodities.NaturalGasRow](ctx, (*Client)(f), query)
+}
+
 func (f *CommoditiesFunctions) Sugar(ctx context.Context, query commodities.SugarQuery) ([]commodities.SugarRow, error) {
 	return queryRows[commodities.SugarRow](ctx, (*Client)(f), query)
 }
 
+func (f *CommoditiesFunctions) SugarSeq(ctx context.Context, query commodities.SugarQuery) iter.Seq2[commodities.SugarRow, error] {
+	return queryRowsSeq[commodities.SugarRow](ctx, (*Client)(f), query)
+}
+
+func (f *CommoditiesFunctions) SugarSeries(ctx context.Context, query commodities.SugarQuery) (api.Series[commodities.SugarRow], error) {
+	return querySeries[commodities.SugarRow](ctx, (*Client)(f), query)
+}
+
 func (f *CommoditiesFunctions) WestTexasIntermediate(ctx context.Context, query commodities.WestTexasIntermediateQuery) ([]commodities.WestTexasIntermediateRow, error) {
 	return queryRows[commodities.WestTexasIntermediateRow](ctx, (*Client)(f), query)
 }
 
+func (f *CommoditiesFunctions) WestTexasIntermediateSeq(ctx context.Context, query commodities.WestTexasIntermediateQuery) iter.Seq2[commodities.WestTexasIntermediateRow, error] {
+	return queryRowsSeq[commodities.WestTexasIntermediateRow](ctx, (*Client)(f), query)
+}
+
+func (f *CommoditiesFunctions) WestTexasIntermediateSeries(ctx context.Context, query commodities.WestTexasIntermediateQuery) (api.Series[commodities.WestTexasIntermediateRow], error) {
+	return querySeries[commodities.WestTexasIntermediateRow](ctx, (*Client)(f), query)
+}
+
 func (f *CommoditiesFunctions) Wheat(ctx context.Context, query commodities.WheatQuery) ([]commodities.WheatRow, error) {
 	return queryRows[commodities.WheatRow](ctx, (*Client)(f), query)
 }
 
+func (f *CommoditiesFunctions) WheatSeq(ctx context.Context, query commodities.WheatQuery) iter.Seq2[commodities.WheatRow, error] {
+	return queryRowsSeq[commodities.WheatRow](ctx, (*Client)(f), query)
+}
+
+func (f *CommoditiesFunctions) WheatSeries(ctx context.Context, query commodities.WheatQuery) (api.Series[commodities.WheatRow], error) {
+	return querySeries[commodities.WheatRow](ctx, (*Client)(f), query)
+}
+
 func (f *CryptoFunctions) Intraday(ctx context.Context, query crypto.IntradayQuery) ([]crypto.IntradayRow, error) {
 	return queryRows[crypto.IntradayRow](ctx, (*Client)(f), query)
 }
 
+func (f *CryptoFunctions) IntradaySeq(ctx context.Context, query crypto.IntradayQuery) iter.Seq2[crypto.IntradayRow, error] {
+	return queryRowsSeq[crypto.IntradayRow](ctx, (*Client)(f), query)
+}
+
+func (f *CryptoFunctions) IntradaySeries(ctx context.Context, query crypto.IntradayQuery) (api.Series[crypto.IntradayRow], error) {
+	return querySeries[crypto.IntradayRow](ctx, (*Client)(f), query)
+}
+
 func (f *CryptoFunctions) DigitalCurrencyDaily(ctx context.Context, query crypto.DigitalCurrencyDailyQuery) ([]crypto.DigitalCurrencyDailyRow, error) {
 	return queryRows[crypto.DigitalCurrencyDailyRow](ctx, (*Client)(f), query)
 }
 
+func (f *CryptoFunctions) DigitalCurrencyDailySeq(ctx context.Context, query crypto.DigitalCurrencyDailyQuery) iter.Seq2[crypto.DigitalCurrencyDailyRow, error] {
+	return queryRowsSeq[crypto.DigitalCurrencyDailyRow](ctx, (*Client)(f), query)
+}
+
+func (f *CryptoFunctions) DigitalCurrencyDailySeries(ctx context.Context, query crypto.DigitalCurrencyDailyQuery) (api.Series[crypto.DigitalCurrencyDailyRow], error) {
+	return querySeries[crypto.DigitalCurrencyDailyRow](ctx, (*Client)(f), query)
+}
+
 func (f *CryptoFunctions) DigitalCurrencyMonthly(ctx context.Context, query crypto.DigitalCurrencyMonthlyQuery) ([]crypto.DigitalCurrencyMonthlyRow, error) {
 	return queryRows[crypto.DigitalCurrencyMonthlyRow](ctx, (*Client)(f), query)
 }
 
+func (f *CryptoFunctions) DigitalCurrencyMonthlySeq(ctx context.Context, query crypto.DigitalCurrencyMonthlyQuery) iter.Seq2[crypto.DigitalCurrencyMonthlyRow, error] {
+	return queryRowsSeq[crypto.DigitalCurrencyMonthlyRow](ctx, (*Client)(f), query)
+}
+
+func (f *CryptoFunctions) DigitalCurrencyMonthlySeries(ctx context.Context, query crypto.DigitalCurrencyMonthlyQuery) (api.Series[crypto.DigitalCurrencyMonthlyRow], error) {
+	return querySeries[crypto.DigitalCurrencyMonthlyRow](ctx, (*Client)(f), query)
+}
+
 func (f *CryptoFunctions) DigitalCurrencyWeekly(ctx context.Context, query crypto.DigitalCurrencyWeeklyQuery) ([]crypto.DigitalCurrencyWeeklyRow, error) {
 	return queryRows[crypto.DigitalCurrencyWeeklyRow](ctx, (*Client)(f), query)
 }
 
+func (f *CryptoFunctions) DigitalCurrencyWeeklySeq(ctx context.Context, query crypto.DigitalCurrencyWeeklyQuery) iter.Seq2[crypto.DigitalCurrencyWeeklyRow, error] {
+	return queryRowsSeq[crypto.DigitalCurrencyWeeklyRow](ctx, (*Client)(f), query)
+}
+
+func (f *CryptoFunctions) DigitalCurrencyWeeklySeries(ctx context.Context, query crypto.DigitalCurrencyWeeklyQuery) (api.Series[crypto.DigitalCurrencyWeeklyRow], error) {
+	return querySeries[crypto.DigitalCurrencyWeeklyRow](ctx, (*Client)(f), query)
+}
+
 func (f *EconomicFunctions) ConsumerPriceIndex(ctx context.Context, query economic.ConsumerPriceIndexQuery) ([]economic.ConsumerPriceIndexRow, error) {
 	return queryRows[economic.ConsumerPriceIndexRow](ctx, (*Client)(f), query)
 }
 
+func (f *EconomicFunctions) ConsumerPriceIndexSeq(ctx context.Context, query economic.ConsumerPriceIndexQuery) iter.Seq2[economic.ConsumerPriceIndexRow, error] {
+	return queryRowsSeq[economic.ConsumerPriceIndexRow](ctx, (*Client)(f), query)
+}
+
+func (f *EconomicFunctions) ConsumerPriceIndexSeries(ctx context.Context, query economic.ConsumerPriceIndexQuery) (api.Series[economic.ConsumerPriceIndexRow], error) {
+	return querySeries[economic.ConsumerPriceIndexRow](ctx, (*Client)(f), query)
+}
+
 func (f *EconomicFunctions) Durables(ctx context.Context, query economic.DurablesQuery) ([]economic.DurablesRow, error) {
 	return queryRows[economic.DurablesRow](ctx, (*Client)(f), query)
 }
 
+func (f *EconomicFunctions) DurablesSeq(ctx context.Context, query economic.DurablesQuery) iter.Seq2[economic.DurablesRow, error] {
+	return queryRowsSeq[economic.DurablesRow](ctx, (*Client)(f), query)
+}
+
+func (f *EconomicFunctions) DurablesSeries(ctx context.Context, query economic.DurablesQuery) (api.Series[economic.DurablesRow], error) {
+	return querySeries[economic.DurablesRow](ctx, (*Client)(f), query)
+}
+
 func (f *EconomicFunctions) FederalFundsRate(ctx context.Context, query economic.FederalFundsRateQuery) ([]economic.FederalFundsRateRow, error) {
 	return queryRows[economic.FederalFundsRateRow](ctx, (*Client)(f), query)
 }
 
+func (f *EconomicFunctions) FederalFundsRateSeq(ctx context.Context, query economic.FederalFundsRateQuery) iter.Seq2[economic.FederalFundsRateRow, error] {
+	return queryRowsSeq[economic.FederalFundsRateRow](ctx, (*Client)(f), query)
+}
+
+func (f *EconomicFunctions) FederalFundsRateSeries(ctx context.Context, query economic.FederalFundsRateQuery) (api.Series[economic.FederalFundsRateRow], error) {
+	return querySeries[economic.FederalFundsRateRow](ctx, (*Client)(f), query)
+}
+
 func (f *EconomicFunctions) Inflation(ctx context.Context, query economic.InflationQuery) ([]economic.InflationRow, error) {
 	return queryRows[economic.InflationRow](ctx, (*Client)(f), query)
 }
 
+func (f *EconomicFunctions) InflationSeq(ctx context.Context, query economic.InflationQuery) iter.Seq2[economic.InflationRow, error] {
+	return queryRowsSeq[economic.InflationRow](ctx, (*Client)(f), query)
+}
+
+func (f *EconomicFunctions) InflationSeries(ctx context.Context, query economic.InflationQuery) (api.Series[economic.InflationRow], error) {
+	return querySeries[economic.InflationRow](ctx, (*Client)(f), query)
+}
+
 func (f *EconomicFunctions) NonFarmPayroll(ctx context.Context, query economic.NonFarmPayrollQuery) ([]economic.NonFarmPayrollRow, error) {
 	return queryRows[economic.NonFarmPayrollRow](ctx, (*Client)(f), query)
 }
 
+func (f *EconomicFunctions) NonFarmPayrollSeq(ctx context.Context, query economic.NonFarmPayrollQuery) iter.Seq2[economic.NonFarmPayrollRow, error] {
+	return queryRowsSeq[economic.NonFarmPayrollRow](ctx, (*Client)(f), query)
+}
+
+func (f *EconomicFunctions) NonFarmPayrollSeries(ctx context.Context, query economic.NonFarmPayrollQuery) (api.Series[economic.NonFarmPayrollRow], error) {
+	return querySeries[economic.NonFarmPayrollRow](ctx, (*Client)(f), query)
+}
+
 func (f *EconomicFunctions) RealGDP(ctx context.Context, query economic.RealGDPQuery) ([]economic.RealGDPRow, error) {
 	return queryRows[economic.RealGDPRow](ctx, (*Client)(f), query)
 }
 
+func (f *EconomicFunctions) RealGDPSeq(ctx context.Context, query economic.RealGDPQuery) iter.Seq2[economic.RealGDPRow, error] {
+	return queryRowsSeq[economic.RealGDPRow](ctx, (*Client)(f), query)
+}
+
+func (f *EconomicFunctions) RealGDPSeries(ctx context.Context, query economic.RealGDPQuery) (api.Series[economic.RealGDPRow], error) {
+	return querySeries[economic.RealGDPRow](ctx, (*Client)(f), query)
+}
+
 func (f *EconomicFunctions) RealGDPPerCapita(ctx context.Context, query economic.RealGDPPerCapitaQuery) ([]economic.RealGDPPerCapitaRow, error) {
 	return queryRows[economic.RealGDPPerCapitaRow](ctx, (*Client)(f), query)
 }
 
+func (f *EconomicFunctions) RealGDPPerCapitaSeq(ctx context.Context, query economic.RealGDPPerCapitaQuery) iter.Seq2[economic.RealGDPPerCapitaRow, error] {
+	return queryRowsSeq[economic.RealGDPPerCapitaRow](ctx, (*Client)(f), query)
+}
+
+func (f *EconomicFunctions) RealGDPPerCapitaSeries(ctx context.Context, query economic.RealGDPPerCapitaQuery) (api.Series[economic.RealGDPPerCapitaRow], error) {
+	return querySeries[economic.RealGDPPerCapitaRow](ctx, (*Client)(f), query)
+}
+
 func (f *EconomicFunctions) RetailSales(ctx context.Context, query economic.RetailSalesQuery) ([]economic.RetailSalesRow, error) {
 	return queryRows[economic.RetailSalesRow](ctx, (*Client)(f), query)
 }
 
+func (f *EconomicFunctions) RetailSalesSeq(ctx context.Context, query economic.RetailSalesQuery) iter.Seq2[economic.RetailSalesRow, error] {
+	return queryRowsSeq[economic.RetailSalesRow](ctx, (*Client)(f), query)
+}
+
+func (f *EconomicFunctions) RetailSalesSeries(ctx context.Context, query economic.RetailSalesQuery) (api.Series[economic.RetailSalesRow], error) {
+	return querySeries[economic.RetailSalesRow](ctx, (*Client)(f), query)
+}
+
 func (f *EconomicFunctions) TreasuryYield(ctx context.Context, query economic.TreasuryYieldQuery) ([]economic.TreasuryYieldRow, error) {
 	return queryRows[economic.TreasuryYieldRow](ctx, (*Client)(f), query)
 }
 
+func (f *EconomicFunctions) TreasuryYieldSeq(ctx context.Context, query economic.TreasuryYieldQuery) iter.Seq2[economic.TreasuryYieldRow, error] {
+	return queryRowsSeq[economic.TreasuryYieldRow](ctx, (*Client)(f), query)
+}
+
+func (f *EconomicFunctions) TreasuryYieldSeries(ctx context.Context, query economic.TreasuryYieldQuery) (api.Series[economic.TreasuryYieldRow], error) {
+	return querySeries[economic.TreasuryYieldRow](ctx, (*Client)(f), query)
+}
+
 func (f *EconomicFunctions) Unemployment(ctx context.Context, query economic.UnemploymentQuery) ([]economic.UnemploymentRow, error) {
 	return queryRows[economic.UnemploymentRow](ctx, (*Client)(f), query)
 }
 
+func (f *EconomicFunctions) UnemploymentSeq(ctx context.Context, query economic.UnemploymentQuery) iter.Seq2[economic.UnemploymentRow, error] {
+	return queryRowsSeq[economic.UnemploymentRow](ctx, (*Client)(f), query)
+}
+
+func (f *EconomicFunctions) UnemploymentSeries(ctx context.Context, query economic.UnemploymentQuery) (api.Series[economic.UnemploymentRow], error) {
+	return querySeries[economic.UnemploymentRow](ctx, (*Client)(f), query)
+}
+
 func (f *ForexFunctions) Daily(ctx context.Context, query forex.DailyQuery) ([]forex.DailyRow, error) {
 	return queryRows[forex.DailyRow](ctx, (*Client)(f), query)
 }
 
+func (f *ForexFunctions) DailySeq(ctx context.Context, query forex.DailyQuery) iter.Seq2[forex.DailyRow, error] {
+	return queryRowsSeq[forex.DailyRow](ctx, (*Client)(f), query)
+}
+
+func (f *ForexFunctions) DailySeries(ctx context.Context, query forex.DailyQuery) (api.Series[forex.DailyRow], error) {
+	return querySeries[forex.DailyRow](ctx, (*Client)(f), query)
+}
+
 func (f *ForexFunctions) Intraday(ctx context.Context, query forex.IntradayQuery) ([]forex.IntradayRow, error) {
 	return queryRows[forex.IntradayRow](ctx, (*Client)(f), query)
 }
 
+func (f *ForexFunctions) IntradaySeq(ctx context.Context, query forex.IntradayQuery) iter.Seq2[forex.IntradayRow, error] {
+	return queryRowsSeq[forex.IntradayRow](ctx, (*Client)(f), query)
+}
+
+func (f *ForexFunctions) IntradaySeries(ctx context.Context, query forex.IntradayQuery) (api.Series[forex.IntradayRow], error) {
+	return querySeries[forex.IntradayRow](ctx, (*Client)(f), query)
+}
+
 func (f *ForexFunctions) Monthly(ctx context.Context, query forex.MonthlyQuery) ([]forex.MonthlyRow, error) {
 	return queryRows[forex.MonthlyRow](ctx, (*Client)(f), query)
 }
 
+func (f *ForexFunctions) MonthlySeq(ctx context.Context, query forex.MonthlyQuery) iter.Seq2[forex.MonthlyRow, error] {
+	return queryRowsSeq[forex.MonthlyRow](ctx, (*Client)(f), query)
+}
+
+func (f *ForexFunctions) MonthlySeries(ctx context.Context, query forex.MonthlyQuery) (api.Series[forex.MonthlyRow], error) {
+	return querySeries[forex.MonthlyRow](ctx, (*Client)(f), query)
+}
+
 func (f *ForexFunctions) Weekly(ctx context.Context, query forex.WeeklyQuery) ([]forex.WeeklyRow, error) {
 	return queryRows[forex.WeeklyRow](ctx, (*Client)(f), query)
 }
 
+func (f *ForexFunctions) WeeklySeq(ctx context.Context, query forex.WeeklyQuery) iter.Seq2[forex.WeeklyRow, error] {
+	return queryRowsSeq[forex.WeeklyRow](ctx, (*Client)(f), query)
+}
+
+func (f *ForexFunctions) WeeklySeries(ctx context.Context, query forex.WeeklyQuery) (api.Series[forex.WeeklyRow], error) {
+	return querySeries[forex.WeeklyRow](ctx, (*Client)(f), query)
+}
+
 func (f *FundamentalFunctions) Dividends(ctx context.Context, query fundamental.DividendsQuery) ([]fundamental.DividendsRow, error) {
 	return queryRows[fundamental.DividendsRow](ctx, (*Client)(f), query)
 }
 
+func (f *FundamentalFunctions) DividendsSeq(ctx context.Context, query fundamental.DividendsQuery) iter.Seq2[fundamental.DividendsRow, error] {
+	return queryRowsSeq[fundamental.DividendsRow](ctx, (*Client)(f), query)
+}
+
+func (f *FundamentalFunctions) DividendsSeries(ctx context.Context, query fundamental.DividendsQuery) (api.Series[fundamental.DividendsRow], error) {
+	return querySeries[fundamental.DividendsRow](ctx, (*Client)(f), query)
+}
+
 func (f *FundamentalFunctions) EarningsCalendar(ctx context.Context, query fundamental.EarningsCalendarQuery) ([]fundamental.EarningsCalendarRow, error) {
 	return queryRows[fundamental.EarningsCalendarRow](ctx, (*Client)(f), query)
 }
 
+func (f *FundamentalFunctions) EarningsCalendarSeq(ctx context.Context, query fundamental.EarningsCalendarQuery) iter.Seq2[fundamental.EarningsCalendarRow, error] {
+	return queryRowsSeq[fundamental.EarningsCalendarRow](ctx, (*Client)(f), query)
+}
+
+func (f *FundamentalFunctions) EarningsCalendarSeries(ctx context.Context, query fundamental.EarningsCalendarQuery) (api.Series[fundamental.EarningsCalendarRow], error) {
+	return querySeries[fundamental.EarningsCalendarRow](ctx, (*Client)(f), query)
+}
+
 func (f *FundamentalFunctions) IPOCalendar(ctx context.Context, query fundamental.IPOCalendarQuery) ([]fundamental.IPOCalendarRow, error) {
 	return queryRows[fundamental.IPOCalendarRow](ctx, (*Client)(f), query)
 }
 
+func (f *FundamentalFunctions) IPOCalendarSeq(ctx context.Context, query fundamental.IPOCalendarQuery) iter.Seq2[fundamental.IPOCalendarRow, error] {
+	return queryRowsSeq[fundamental.IPOCalendarRow](ctx, (*Client)(f), query)
+}
+
+func (f *FundamentalFunctions) IPOCalendarSeries(ctx context.Context, query fundamental.IPOCalendarQuery) (api.Series[fundamental.IPOCalendarRow], error) {
+	return querySeries[fundamental.IPOCalendarRow](ctx, (*Client)(f), query)
+}
+
 func (f *FundamentalFunctions) ListingStatus(ctx context.Context, query fundamental.ListingStatusQuery) ([]fundamental.ListingStatusRow, error) {
 	return queryRows[fundamental.ListingStatusRow](ctx, (*Client)(f), query)
 }
 
+func (f *FundamentalFunctions) ListingStatusSeq(ctx context.Context, query fundamental.ListingStatusQuery) iter.Seq2[fundamental.ListingStatusRow, error] {
+	return queryRowsSeq[fundamental.ListingStatusRow](ctx, (*Client)(f), query)
+}
+
+func (f *FundamentalFunctions) ListingStatusSeries(ctx context.Context, query fundamental.ListingStatusQuery) (api.Series[fundamental.ListingStatusRow], error) {
+	return querySeries[fundamental.ListingStatusRow](ctx, (*Client)(f), query)
+}
+
 func (f *FundamentalFunctions) SharesOutstanding(ctx context.Context, query fundamental.SharesOutstandingQuery) ([]fundamental.SharesOutstandingRow, error) {
 	return queryRows[fundamental.SharesOutstandingRow](ctx, (*Client)(f), query)
 }
 
+func (f *FundamentalFunctions) SharesOutstandingSeq(ctx context.Context, query fundamental.SharesOutstandingQuery) iter.Seq2[fundamental.SharesOutstandingRow, error] {
+	return queryRowsSeq[fundamental.SharesOutstandingRow](ctx, (*Client)(f), query)
+}
+
+func (f *FundamentalFunctions) SharesOutstandingSeries(ctx context.Context, query fundamental.SharesOutstandingQuery) (api.Series[fundamental.SharesOutstandingRow], error) {
+	return querySeries[fundamental.SharesOutstandingRow](ctx, (*Client)(f), query)
+}
+
 func (f *FundamentalFunctions) Splits(ctx context.Context, query fundamental.SplitsQuery) ([]fundamental.SplitsRow, error) {
 	return queryRows[fundamental.SplitsRow](ctx, (*Client)(f), query)
 }
 
+func (f *FundamentalFunctions) SplitsSeq(ctx context.Context, query fundamental.SplitsQuery) iter.Seq2[fundamental.SplitsRow, error] {
+	return queryRowsSeq[fundamental.SplitsRow](ctx, (*Client)(f), query)
+}
+
+func (f *FundamentalFunctions) SplitsSeries(ctx context.Context, query fundamental.SplitsQuery) (api.Series[fundamental.SplitsRow], error) {
+	return querySeries[fundamental.SplitsRow](ctx, (*Client)(f), query)
+}
+
 func (f *OptionsFunctions) Historical(ctx context.Context, query options.HistoricalQuery) ([]options.HistoricalRow, error) {
 	return queryRows[options.HistoricalRow](ctx, (*Client)(f), query)
 }
 
+func (f *OptionsFunctions) HistoricalSeq(ctx context.Context, query options.HistoricalQuery) iter.Seq2[options.HistoricalRow, error] {
+	return queryRowsSeq[options.HistoricalRow](ctx, (*Client)(f), query)
+}
+
+func (f *OptionsFunctions) HistoricalSeries(ctx context.Context, query options.HistoricalQuery) (api.Series[options.HistoricalRow], error) {
+	return querySeries[options.HistoricalRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) AbsolutePriceOscillator(ctx context.Context, query technical.AbsolutePriceOscillatorQuery) ([]technical.AbsolutePriceOscillatorRow, error) {
 	return queryRows[technical.AbsolutePriceOscillatorRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) AbsolutePriceOscillatorSeq(ctx context.Context, query technical.AbsolutePriceOscillatorQuery) iter.Seq2[technical.AbsolutePriceOscillatorRow, error] {
+	return queryRowsSeq[technical.AbsolutePriceOscillatorRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) AbsolutePriceOscillatorSeries(ctx context.Context, query technical.AbsolutePriceOscillatorQuery) (api.Series[technical.AbsolutePriceOscillatorRow], error) {
+	return querySeries[technical.AbsolutePriceOscillatorRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) Aroon(ctx context.Context, query technical.AroonQuery) ([]technical.AroonRow, error) {
 	return queryRows[technical.AroonRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) AroonSeq(ctx context.Context, query technical.AroonQuery) iter.Seq2[technical.AroonRow, error] {
+	return queryRowsSeq[technical.AroonRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) AroonSeries(ctx context.Context, query technical.AroonQuery) (api.Series[technical.AroonRow], error) {
+	return querySeries[technical.AroonRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) AroonOsc(ctx context.Context, query technical.AroonOscQuery) ([]technical.AroonOscRow, error) {
 	return queryRows[technical.AroonOscRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) AroonOscSeq(ctx context.Context, query technical.AroonOscQuery) iter.Seq2[technical.AroonOscRow, error] {
+	return queryRowsSeq[technical.AroonOscRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) AroonOscSeries(ctx context.Context, query technical.AroonOscQuery) (api.Series[technical.AroonOscRow], error) {
+	return querySeries[technical.AroonOscRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) AverageDirectionalMovementIndex(ctx context.Context, query technical.AverageDirectionalMovementIndexQuery) ([]technical.AverageDirectionalMovementIndexRow, error) {
 	return queryRows[technical.AverageDirectionalMovementIndexRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) AverageDirectionalMovementIndexSeq(ctx context.Context, query technical.AverageDirectionalMovementIndexQuery) iter.Seq2[technical.AverageDirectionalMovementIndexRow, error] {
+	return queryRowsSeq[technical.AverageDirectionalMovementIndexRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) AverageDirectionalMovementIndexSeries(ctx context.Context, query technical.AverageDirectionalMovementIndexQuery) (api.Series[technical.AverageDirectionalMovementIndexRow], error) {
+	return querySeries[technical.AverageDirectionalMovementIndexRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) AverageDirectionalMovementIndexRating(ctx context.Context, query technical.AverageDirectionalMovementIndexRatingQuery) ([]technical.AverageDirectionalMovementIndexRatingRow, error) {
 	return queryRows[technical.AverageDirectionalMovementIndexRatingRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) AverageDirectionalMovementIndexRatingSeq(ctx context.Context, query technical.AverageDirectionalMovementIndexRatingQuery) iter.Seq2[technical.AverageDirectionalMovementIndexRatingRow, error] {
+	return queryRowsSeq[technical.AverageDirectionalMovementIndexRatingRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) AverageDirectionalMovementIndexRatingSeries(ctx context.Context, query technical.AverageDirectionalMovementIndexRatingQuery) (api.Series[technical.AverageDirectionalMovementIndexRatingRow], error) {
+	return querySeries[technical.AverageDirectionalMovementIndexRatingRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) AverageTrueRange(ctx context.Context, query technical.AverageTrueRangeQuery) ([]technical.AverageTrueRangeRow, error) {
 	return queryRows[technical.AverageTrueRangeRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) AverageTrueRangeSeq(ctx context.Context, query technical.AverageTrueRangeQuery) iter.Seq2[technical.AverageTrueRangeRow, error] {
+	return queryRowsSeq[technical.AverageTrueRangeRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) AverageTrueRangeSeries(ctx context.Context, query technical.AverageTrueRangeQuery) (api.Series[technical.AverageTrueRangeRow], error) {
+	return querySeries[technical.AverageTrueRangeRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) BalanceOfPower(ctx context.Context, query technical.BalanceOfPowerQuery) ([]technical.BalanceOfPowerRow, error) {
 	return queryRows[technical.BalanceOfPowerRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) BalanceOfPowerSeq(ctx context.Context, query technical.BalanceOfPowerQuery) iter.Seq2[technical.BalanceOfPowerRow, error] {
+	return queryRowsSeq[technical.BalanceOfPowerRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) BalanceOfPowerSeries(ctx context.Context, query technical.BalanceOfPowerQuery) (api.Series[technical.BalanceOfPowerRow], error) {
+	return querySeries[technical.BalanceOfPowerRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) BollingerBands(ctx context.Context, query technical.BollingerBandsQuery) ([]technical.BollingerBandsRow, error) {
 	return queryRows[technical.BollingerBandsRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) BollingerBandsSeq(ctx context.Context, query technical.BollingerBandsQuery) iter.Seq2[technical.BollingerBandsRow, error] {
+	return queryRowsSeq[technical.BollingerBandsRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) BollingerBandsSeries(ctx context.Context, query technical.BollingerBandsQuery) (api.Series[technical.BollingerBandsRow], error) {
+	return querySeries[technical.BollingerBandsRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) ChaikinADLine(ctx context.Context, query technical.ChaikinADLineQuery) ([]technical.ChaikinADLineRow, error) {
 	return queryRows[technical.ChaikinADLineRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) ChaikinADLineSeq(ctx context.Context, query technical.ChaikinADLineQuery) iter.Seq2[technical.ChaikinADLineRow, error] {
+	return queryRowsSeq[technical.ChaikinADLineRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) ChaikinADLineSeries(ctx context.Context, query technical.ChaikinADLineQuery) (api.Series[technical.ChaikinADLineRow], error) {
+	return querySeries[technical.ChaikinADLineRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) ChaikinADOscillator(ctx context.Context, query technical.ChaikinADOscillatorQuery) ([]technical.ChaikinADOscillatorRow, error) {
 	return queryRows[technical.ChaikinADOscillatorRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) ChaikinADOscillatorSeq(ctx context.Context, query technical.ChaikinADOscillatorQuery) iter.Seq2[technical.ChaikinADOscillatorRow, error] {
+	return queryRowsSeq[technical.ChaikinADOscillatorRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) ChaikinADOscillatorSeries(ctx context.Context, query technical.ChaikinADOscillatorQuery) (api.Series[technical.ChaikinADOscillatorRow], error) {
+	return querySeries[technical.ChaikinADOscillatorRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) ChandeMomentumOscillator(ctx context.Context, query technical.ChandeMomentumOscillatorQuery) ([]technical.ChandeMomentumOscillatorRow, error) {
 	return queryRows[technical.ChandeMomentumOscillatorRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) ChandeMomentumOscillatorSeq(ctx context.Context, query technical.ChandeMomentumOscillatorQuery) iter.Seq2[technical.ChandeMomentumOscillatorRow, error] {
+	return queryRowsSeq[technical.ChandeMomentumOscillatorRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) ChandeMomentumOscillatorSeries(ctx context.Context, query technical.ChandeMomentumOscillatorQuery) (api.Series[technical.ChandeMomentumOscillatorRow], error) {
+	return querySeries[technical.ChandeMomentumOscillatorRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) CommodityChannelIndex(ctx context.Context, query technical.CommodityChannelIndexQuery) ([]technical.CommodityChannelIndexRow, error) {
 	return queryRows[technical.CommodityChannelIndexRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) CommodityChannelIndexSeq(ctx context.Context, query technical.CommodityChannelIndexQuery) iter.Seq2[technical.CommodityChannelIndexRow, error] {
+	return queryRowsSeq[technical.CommodityChannelIndexRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) CommodityChannelIndexSeries(ctx context.Context, query technical.CommodityChannelIndexQuery) (api.Series[technical.CommodityChannelIndexRow], error) {
+	return querySeries[technical.CommodityChannelIndexRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) DirectionalMovementIndex(ctx context.Context, query technical.DirectionalMovementIndexQuery) ([]technical.DirectionalMovementIndexRow, error) {
 	return queryRows[technical.DirectionalMovementIndexRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) DirectionalMovementIndexSeq(ctx context.Context, query technical.DirectionalMovementIndexQuery) iter.Seq2[technical.DirectionalMovementIndexRow, error] {
+	return queryRowsSeq[technical.DirectionalMovementIndexRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) DirectionalMovementIndexSeries(ctx context.Context, query technical.DirectionalMovementIndexQuery) (api.Series[technical.DirectionalMovementIndexRow], error) {
+	return querySeries[technical.DirectionalMovementIndexRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) DoubleExponentialMovingAverage(ctx context.Context, query technical.DoubleExponentialMovingAverageQuery) ([]technical.DoubleExponentialMovingAverageRow, error) {
 	return queryRows[technical.DoubleExponentialMovingAverageRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) DoubleExponentialMovingAverageSeq(ctx context.Context, query technical.DoubleExponentialMovingAverageQuery) iter.Seq2[technical.DoubleExponentialMovingAverageRow, error] {
+	return queryRowsSeq[technical.DoubleExponentialMovingAverageRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) DoubleExponentialMovingAverageSeries(ctx context.Context, query technical.DoubleExponentialMovingAverageQuery) (api.Series[technical.DoubleExponentialMovingAverageRow], error) {
+	return querySeries[technical.DoubleExponentialMovingAverageRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) ExponentialMovingAverage(ctx context.Context, query technical.ExponentialMovingAverageQuery) ([]technical.ExponentialMovingAverageRow, error) {
 	return queryRows[technical.ExponentialMovingAverageRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) ExponentialMovingAverageSeq(ctx context.Context, query technical.ExponentialMovingAverageQuery) iter.Seq2[technical.ExponentialMovingAverageRow, error] {
+	return queryRowsSeq[technical.ExponentialMovingAverageRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) ExponentialMovingAverageSeries(ctx context.Context, query technical.ExponentialMovingAverageQuery) (api.Series[technical.ExponentialMovingAverageRow], error) {
+	return querySeries[technical.ExponentialMovingAverageRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) HilbertTransformDCPeriod(ctx context.Context, query technical.HilbertTransformDCPeriodQuery) ([]technical.HilbertTransformDCPeriodRow, error) {
 	return queryRows[technical.HilbertTransformDCPeriodRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) HilbertTransformDCPeriodSeq(ctx context.Context, query technical.HilbertTransformDCPeriodQuery) iter.Seq2[technical.HilbertTransformDCPeriodRow, error] {
+	return queryRowsSeq[technical.HilbertTransformDCPeriodRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) HilbertTransformDCPeriodSeries(ctx context.Context, query technical.HilbertTransformDCPeriodQuery) (api.Series[technical.HilbertTransformDCPeriodRow], error) {
+	return querySeries[technical.HilbertTransformDCPeriodRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) HilbertTransformDCPhase(ctx context.Context, query technical.HilbertTransformDCPhaseQuery) ([]technical.HilbertTransformDCPhaseRow, error) {
 	return queryRows[technical.HilbertTransformDCPhaseRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) HilbertTransformDCPhaseSeq(ctx context.Context, query technical.HilbertTransformDCPhaseQuery) iter.Seq2[technical.HilbertTransformDCPhaseRow, error] {
+	return queryRowsSeq[technical.HilbertTransformDCPhaseRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) HilbertTransformDCPhaseSeries(ctx context.Context, query technical.HilbertTransformDCPhaseQuery) (api.Series[technical.HilbertTransformDCPhaseRow], error) {
+	return querySeries[technical.HilbertTransformDCPhaseRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) HilbertTransformPhasor(ctx context.Context, query technical.HilbertTransformPhasorQuery) ([]technical.HilbertTransformPhasorRow, error) {
 	return queryRows[technical.HilbertTransformPhasorRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) HilbertTransformPhasorSeq(ctx context.Context, query technical.HilbertTransformPhasorQuery) iter.Seq2[technical.HilbertTransformPhasorRow, error] {
+	return queryRowsSeq[technical.HilbertTransformPhasorRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) HilbertTransformPhasorSeries(ctx context.Context, query technical.HilbertTransformPhasorQuery) (api.Series[technical.HilbertTransformPhasorRow], error) {
+	return querySeries[technical.HilbertTransformPhasorRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) HilbertTransformSine(ctx context.Context, query technical.HilbertTransformSineQuery) ([]technical.HilbertTransformSineRow, error) {
 	return queryRows[technical.HilbertTransformSineRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) HilbertTransformSineSeq(ctx context.Context, query technical.HilbertTransformSineQuery) iter.Seq2[technical.HilbertTransformSineRow, error] {
+	return queryRowsSeq[technical.HilbertTransformSineRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) HilbertTransformSineSeries(ctx context.Context, query technical.HilbertTransformSineQuery) (api.Series[technical.HilbertTransformSineRow], error) {
+	return querySeries[technical.HilbertTransformSineRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) HilbertTransformTrendLine(ctx context.Context, query technical.HilbertTransformTrendLineQuery) ([]technical.HilbertTransformTrendLineRow, error) {
 	return queryRows[technical.HilbertTransformTrendLineRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) HilbertTransformTrendLineSeq(ctx context.Context, query technical.HilbertTransformTrendLineQuery) iter.Seq2[technical.HilbertTransformTrendLineRow, error] {
+	return queryRowsSeq[technical.HilbertTransformTrendLineRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) HilbertTransformTrendLineSeries(ctx context.Context, query technical.HilbertTransformTrendLineQuery) (api.Series[technical.HilbertTransformTrendLineRow], error) {
+	return querySeries[technical.HilbertTransformTrendLineRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) HilbertTransformTrendMode(ctx context.Context, query technical.HilbertTransformTrendModeQuery) ([]technical.HilbertTransformTrendModeRow, error) {
 	return queryRows[technical.HilbertTransformTrendModeRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) HilbertTransformTrendModeSeq(ctx context.Context, query technical.HilbertTransformTrendModeQuery) iter.Seq2[technical.HilbertTransformTrendModeRow, error] {
+	return queryRowsSeq[technical.HilbertTransformTrendModeRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) HilbertTransformTrendModeSeries(ctx context.Context, query technical.HilbertTransformTrendModeQuery) (api.Series[technical.HilbertTransformTrendModeRow], error) {
+	return querySeries[technical.HilbertTransformTrendModeRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) KaufmanAdaptiveMovingAverage(ctx context.Context, query technical.KaufmanAdaptiveMovingAverageQuery) ([]technical.KaufmanAdaptiveMovingAverageRow, error) {
 	return queryRows[technical.KaufmanAdaptiveMovingAverageRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) KaufmanAdaptiveMovingAverageSeq(ctx context.Context, query technical.KaufmanAdaptiveMovingAverageQuery) iter.Seq2[technical.KaufmanAdaptiveMovingAverageRow, error] {
+	return queryRowsSeq[technical.KaufmanAdaptiveMovingAverageRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) KaufmanAdaptiveMovingAverageSeries(ctx context.Context, query technical.KaufmanAdaptiveMovingAverageQuery) (api.Series[technical.KaufmanAdaptiveMovingAverageRow], error) {
+	return querySeries[technical.KaufmanAdaptiveMovingAverageRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) MESAAdaptiveMovingAverage(ctx context.Context, query technical.MESAAdaptiveMovingAverageQuery) ([]technical.MESAAdaptiveMovingAverageRow, error) {
 	return queryRows[technical.MESAAdaptiveMovingAverageRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) MESAAdaptiveMovingAverageSeq(ctx context.Context, query technical.MESAAdaptiveMovingAverageQuery) iter.Seq2[technical.MESAAdaptiveMovingAverageRow, error] {
+	return queryRowsSeq[technical.MESAAdaptiveMovingAverageRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) MESAAdaptiveMovingAverageSeries(ctx context.Context, query technical.MESAAdaptiveMovingAverageQuery) (api.Series[technical.MESAAdaptiveMovingAverageRow], error) {
+	return querySeries[technical.MESAAdaptiveMovingAverageRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) MidPoint(ctx context.Context, query technical.MidPointQuery) ([]technical.MidPointRow, error) {
 	return queryRows[technical.MidPointRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) MidPointSeq(ctx context.Context, query technical.MidPointQuery) iter.Seq2[technical.MidPointRow, error] {
+	return queryRowsSeq[technical.MidPointRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) MidPointSeries(ctx context.Context, query technical.MidPointQuery) (api.Series[technical.MidPointRow], error) {
+	return querySeries[technical.MidPointRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) MidPrice(ctx context.Context, query technical.MidPriceQuery) ([]technical.MidPriceRow, error) {
 	return queryRows[technical.MidPriceRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) MidPriceSeq(ctx context.Context, query technical.MidPriceQuery) iter.Seq2[technical.MidPriceRow, error] {
+	return queryRowsSeq[technical.MidPriceRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) MidPriceSeries(ctx context.Context, query technical.MidPriceQuery) (api.Series[technical.MidPriceRow], error) {
+	return querySeries[technical.MidPriceRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) MinusDirectionalIndicator(ctx context.Context, query technical.MinusDirectionalIndicatorQuery) ([]technical.MinusDirectionalIndicatorRow, error) {
 	return queryRows[technical.MinusDirectionalIndicatorRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) MinusDirectionalIndicatorSeq(ctx context.Context, query technical.MinusDirectionalIndicatorQuery) iter.Seq2[technical.MinusDirectionalIndicatorRow, error] {
+	return queryRowsSeq[technical.MinusDirectionalIndicatorRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) MinusDirectionalIndicatorSeries(ctx context.Context, query technical.MinusDirectionalIndicatorQuery) (api.Series[technical.MinusDirectionalIndicatorRow], error) {
+	return querySeries[technical.MinusDirectionalIndicatorRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) MinusDirectionalMovement(ctx context.Context, query technical.MinusDirectionalMovementQuery) ([]technical.MinusDirectionalMovementRow, error) {
 	return queryRows[technical.MinusDirectionalMovementRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) MinusDirectionalMovementSeq(ctx context.Context, query technical.MinusDirectionalMovementQuery) iter.Seq2[technical.MinusDirectionalMovementRow, error] {
+	return queryRowsSeq[technical.MinusDirectionalMovementRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) MinusDirectionalMovementSeries(ctx context.Context, query technical.MinusDirectionalMovementQuery) (api.Series[technical.MinusDirectionalMovementRow], error) {
+	return querySeries[technical.MinusDirectionalMovementRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) Momentum(ctx context.Context, query technical.MomentumQuery) ([]technical.MomentumRow, error) {
 	return queryRows[technical.MomentumRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) MomentumSeq(ctx context.Context, query technical.MomentumQuery) iter.Seq2[technical.MomentumRow, error] {
+	return queryRowsSeq[technical.MomentumRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) MomentumSeries(ctx context.Context, query technical.MomentumQuery) (api.Series[technical.MomentumRow], error) {
+	return querySeries[technical.MomentumRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) MoneyFlowIndex(ctx context.Context, query technical.MoneyFlowIndexQuery) ([]technical.MoneyFlowIndexRow, error) {
 	return queryRows[technical.MoneyFlowIndexRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) MoneyFlowIndexSeq(ctx context.Context, query technical.MoneyFlowIndexQuery) iter.Seq2[technical.MoneyFlowIndexRow, error] {
+	return queryRowsSeq[technical.MoneyFlowIndexRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) MoneyFlowIndexSeries(ctx context.Context, query technical.MoneyFlowIndexQuery) (api.Series[technical.MoneyFlowIndexRow], error) {
+	return querySeries[technical.MoneyFlowIndexRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) MovingAverageConvergenceDivergence(ctx context.Context, query technical.MovingAverageConvergenceDivergenceQuery) ([]technical.MovingAverageConvergenceDivergenceRow, error) {
 	return queryRows[technical.MovingAverageConvergenceDivergenceRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) MovingAverageConvergenceDivergenceSeq(ctx context.Context, query technical.MovingAverageConvergenceDivergenceQuery) iter.Seq2[technical.MovingAverageConvergenceDivergenceRow, error] {
+	return queryRowsSeq[technical.MovingAverageConvergenceDivergenceRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) MovingAverageConvergenceDivergenceSeries(ctx context.Context, query technical.MovingAverageConvergenceDivergenceQuery) (api.Series[technical.MovingAverageConvergenceDivergenceRow], error) {
+	return querySeries[technical.MovingAverageConvergenceDivergenceRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) MovingAverageConvergenceDivergenceExt(ctx context.Context, query technical.MovingAverageConvergenceDivergenceExtQuery) ([]technical.MovingAverageConvergenceDivergenceExtRow, error) {
 	return queryRows[technical.MovingAverageConvergenceDivergenceExtRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) MovingAverageConvergenceDivergenceExtSeq(ctx context.Context, query technical.MovingAverageConvergenceDivergenceExtQuery) iter.Seq2[technical.MovingAverageConvergenceDivergenceExtRow, error] {
+	return queryRowsSeq[technical.MovingAverageConvergenceDivergenceExtRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) MovingAverageConvergenceDivergenceExtSeries(ctx context.Context, query technical.MovingAverageConvergenceDivergenceExtQuery) (api.Series[technical.MovingAverageConvergenceDivergenceExtRow], error) {
+	return querySeries[technical.MovingAverageConvergenceDivergenceExtRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) NormalizedAverageTrueRange(ctx context.Context, query technical.NormalizedAverageTrueRangeQuery) ([]technical.NormalizedAverageTrueRangeRow, error) {
 	return queryRows[technical.NormalizedAverageTrueRangeRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) NormalizedAverageTrueRangeSeq(ctx context.Context, query technical.NormalizedAverageTrueRangeQuery) iter.Seq2[technical.NormalizedAverageTrueRangeRow, error] {
+	return queryRowsSeq[technical.NormalizedAverageTrueRangeRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) NormalizedAverageTrueRangeSeries(ctx context.Context, query technical.NormalizedAverageTrueRangeQuery) (api.Series[technical.NormalizedAverageTrueRangeRow], error) {
+	return querySeries[technical.NormalizedAverageTrueRangeRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) OnBalanceVolume(ctx context.Context, query technical.OnBalanceVolumeQuery) ([]technical.OnBalanceVolumeRow, error) {
 	return queryRows[technical.OnBalanceVolumeRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) OnBalanceVolumeSeq(ctx context.Context, query technical.OnBalanceVolumeQuery) iter.Seq2[technical.OnBalanceVolumeRow, error] {
+	return queryRowsSeq[technical.OnBalanceVolumeRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) OnBalanceVolumeSeries(ctx context.Context, query technical.OnBalanceVolumeQuery) (api.Series[technical.OnBalanceVolumeRow], error) {
+	return querySeries[technical.OnBalanceVolumeRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) OneDayRateOfChangeTripleSmoothExponentialMovingAverage(ctx context.Context, query technical.OneDayRateOfChangeTripleSmoothExponentialMovingAverageQuery) ([]technical.OneDayRateOfChangeTripleSmoothExponentialMovingAverageRow, error) {
 	return queryRows[technical.OneDayRateOfChangeTripleSmoothExponentialMovingAverageRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) OneDayRateOfChangeTripleSmoothExponentialMovingAverageSeq(ctx context.Context, query technical.OneDayRateOfChangeTripleSmoothExponentialMovingAverageQuery) iter.Seq2[technical.OneDayRateOfChangeTripleSmoothExponentialMovingAverageRow, error] {
+	return queryRowsSeq[technical.OneDayRateOfChangeTripleSmoothExponentialMovingAverageRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) OneDayRateOfChangeTripleSmoothExponentialMovingAverageSeries(ctx context.Context, query technical.OneDayRateOfChangeTripleSmoothExponentialMovingAverageQuery) (api.Series[technical.OneDayRateOfChangeTripleSmoothExponentialMovingAverageRow], error) {
+	return querySeries[technical.OneDayRateOfChangeTripleSmoothExponentialMovingAverageRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) PercentagePriceOscillator(ctx context.Context, query technical.PercentagePriceOscillatorQuery) ([]technical.PercentagePriceOscillatorRow, error) {
 	return queryRows[technical.PercentagePriceOscillatorRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) PercentagePriceOscillatorSeq(ctx context.Context, query technical.PercentagePriceOscillatorQuery) iter.Seq2[technical.PercentagePriceOscillatorRow, error] {
+	return queryRowsSeq[technical.PercentagePriceOscillatorRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) PercentagePriceOscillatorSeries(ctx context.Context, query technical.PercentagePriceOscillatorQuery) (api.Series[technical.PercentagePriceOscillatorRow], error) {
+	return querySeries[technical.PercentagePriceOscillatorRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) PlusDirectionalIndicator(ctx context.Context, query technical.PlusDirectionalIndicatorQuery) ([]technical.PlusDirectionalIndicatorRow, error) {
 	return queryRows[technical.PlusDirectionalIndicatorRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) PlusDirectionalIndicatorSeq(ctx context.Context, query technical.PlusDirectionalIndicatorQuery) iter.Seq2[technical.PlusDirectionalIndicatorRow, error] {
+	return queryRowsSeq[technical.PlusDirectionalIndicatorRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) PlusDirectionalIndicatorSeries(ctx context.Context, query technical.PlusDirectionalIndicatorQuery) (api.Series[technical.PlusDirectionalIndicatorRow], error) {
+	return querySeries[technical.PlusDirectionalIndicatorRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) PlusDirectionalMovement(ctx context.Context, query technical.PlusDirectionalMovementQuery) ([]technical.PlusDirectionalMovementRow, error) {
 	return queryRows[technical.PlusDirectionalMovementRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) PlusDirectionalMovementSeq(ctx context.Context, query technical.PlusDirectionalMovementQuery) iter.Seq2[technical.PlusDirectionalMovementRow, error] {
+	return queryRowsSeq[technical.PlusDirectionalMovementRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) PlusDirectionalMovementSeries(ctx context.Context, query technical.PlusDirectionalMovementQuery) (api.Series[technical.PlusDirectionalMovementRow], error) {
+	return querySeries[technical.PlusDirectionalMovementRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) RateOfChange(ctx context.Context, query technical.RateOfChangeQuery) ([]technical.RateOfChangeRow, error) {
 	return queryRows[technical.RateOfChangeRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) RateOfChangeSeq(ctx context.Context, query technical.RateOfChangeQuery) iter.Seq2[technical.RateOfChangeRow, error] {
+	return queryRowsSeq[technical.RateOfChangeRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) RateOfChangeSeries(ctx context.Context, query technical.RateOfChangeQuery) (api.Series[technical.RateOfChangeRow], error) {
+	return querySeries[technical.RateOfChangeRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) RateOfChangeRatio(ctx context.Context, query technical.RateOfChangeRatioQuery) ([]technical.RateOfChangeRatioRow, error) {
 	return queryRows[technical.RateOfChangeRatioRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) RateOfChangeRatioSeq(ctx context.Context, query technical.RateOfChangeRatioQuery) iter.Seq2[technical.RateOfChangeRatioRow, error] {
+	return queryRowsSeq[technical.RateOfChangeRatioRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) RateOfChangeRatioSeries(ctx context.Context, query technical.RateOfChangeRatioQuery) (api.Series[technical.RateOfChangeRatioRow], error) {
+	return querySeries[technical.RateOfChangeRatioRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) RelativeStrengthIndex(ctx context.Context, query technical.RelativeStrengthIndexQuery) ([]technical.RelativeStrengthIndexRow, error) {
 	return queryRows[technical.RelativeStrengthIndexRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) RelativeStrengthIndexSeq(ctx context.Context, query technical.RelativeStrengthIndexQuery) iter.Seq2[technical.RelativeStrengthIndexRow, error] {
+	return queryRowsSeq[technical.RelativeStrengthIndexRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) RelativeStrengthIndexSeries(ctx context.Context, query technical.RelativeStrengthIndexQuery) (api.Series[technical.RelativeStrengthIndexRow], error) {
+	return querySeries[technical.RelativeStrengthIndexRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) SAR(ctx context.Context, query technical.SARQuery) ([]technical.SARRow, error) {
 	return queryRows[technical.SARRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) SARSeq(ctx context.Context, query technical.SARQuery) iter.Seq2[technical.SARRow, error] {
+	return queryRowsSeq[technical.SARRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) SARSeries(ctx context.Context, query technical.SARQuery) (api.Series[technical.SARRow], error) {
+	return querySeries[technical.SARRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) SimpleMovingAverage(ctx context.Context, query technical.SimpleMovingAverageQuery) ([]technical.SimpleMovingAverageRow, error) {
 	return queryRows[technical.SimpleMovingAverageRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) SimpleMovingAverageSeq(ctx context.Context, query technical.SimpleMovingAverageQuery) iter.Seq2[technical.SimpleMovingAverageRow, error] {
+	return queryRowsSeq[technical.SimpleMovingAverageRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) SimpleMovingAverageSeries(ctx context.Context, query technical.SimpleMovingAverageQuery) (api.Series[technical.SimpleMovingAverageRow], error) {
+	return querySeries[technical.SimpleMovingAverageRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) StochasticFast(ctx context.Context, query technical.StochasticFastQuery) ([]technical.StochasticFastRow, error) {
 	return queryRows[technical.StochasticFastRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) StochasticFastSeq(ctx context.Context, query technical.StochasticFastQuery) iter.Seq2[technical.StochasticFastRow, error] {
+	return queryRowsSeq[technical.StochasticFastRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) StochasticFastSeries(ctx context.Context, query technical.StochasticFastQuery) (api.Series[technical.StochasticFastRow], error) {
+	return querySeries[technical.StochasticFastRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) StochasticOscillator(ctx context.Context, query technical.StochasticOscillatorQuery) ([]technical.StochasticOscillatorRow, error) {
 	return queryRows[technical.StochasticOscillatorRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) StochasticOscillatorSeq(ctx context.Context, query technical.StochasticOscillatorQuery) iter.Seq2[technical.StochasticOscillatorRow, error] {
+	return queryRowsSeq[technical.StochasticOscillatorRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) StochasticOscillatorSeries(ctx context.Context, query technical.StochasticOscillatorQuery) (api.Series[technical.StochasticOscillatorRow], error) {
+	return querySeries[technical.StochasticOscillatorRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) StochasticRelativeStrengthIndex(ctx context.Context, query technical.StochasticRelativeStrengthIndexQuery) ([]technical.StochasticRelativeStrengthIndexRow, error) {
 	return queryRows[technical.StochasticRelativeStrengthIndexRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) StochasticRelativeStrengthIndexSeq(ctx context.Context, query technical.StochasticRelativeStrengthIndexQuery) iter.Seq2[technical.StochasticRelativeStrengthIndexRow, error] {
+	return queryRowsSeq[technical.StochasticRelativeStrengthIndexRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) StochasticRelativeStrengthIndexSeries(ctx context.Context, query technical.StochasticRelativeStrengthIndexQuery) (api.Series[technical.StochasticRelativeStrengthIndexRow], error) {
+	return querySeries[technical.StochasticRelativeStrengthIndexRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) T3(ctx context.Context, query technical.T3Query) ([]technical.T3Row, error) {
 	return queryRows[technical.T3Row](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) T3Seq(ctx context.Context, query technical.T3Query) iter.Seq2[technical.T3Row, error] {
+	return queryRowsSeq[technical.T3Row](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) T3Series(ctx context.Context, query technical.T3Query) (api.Series[technical.T3Row], error) {
+	return querySeries[technical.T3Row](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) TriangularMovingAverage(ctx context.Context, query technical.TriangularMovingAverageQuery) ([]technical.TriangularMovingAverageRow, error) {
 	return queryRows[technical.TriangularMovingAverageRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) TriangularMovingAverageSeq(ctx context.Context, query technical.TriangularMovingAverageQuery) iter.Seq2[technical.TriangularMovingAverageRow, error] {
+	return queryRowsSeq[technical.TriangularMovingAverageRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) TriangularMovingAverageSeries(ctx context.Context, query technical.TriangularMovingAverageQuery) (api.Series[technical.TriangularMovingAverageRow], error) {
+	return querySeries[technical.TriangularMovingAverageRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) TripleExponentialMovingAverage(ctx context.Context, query technical.TripleExponentialMovingAverageQuery) ([]technical.TripleExponentialMovingAverageRow, error) {
 	return queryRows[technical.TripleExponentialMovingAverageRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) TripleExponentialMovingAverageSeq(ctx context.Context, query technical.TripleExponentialMovingAverageQuery) iter.Seq2[technical.TripleExponentialMovingAverageRow, error] {
+	return queryRowsSeq[technical.TripleExponentialMovingAverageRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) TripleExponentialMovingAverageSeries(ctx context.Context, query technical.TripleExponentialMovingAverageQuery) (api.Series[technical.TripleExponentialMovingAverageRow], error) {
+	return querySeries[technical.TripleExponentialMovingAverageRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) TrueRange(ctx context.Context, query technical.TrueRangeQuery) ([]technical.TrueRangeRow, error) {
 	return queryRows[technical.TrueRangeRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) TrueRangeSeq(ctx context.Context, query technical.TrueRangeQuery) iter.Seq2[technical.TrueRangeRow, error] {
+	return queryRowsSeq[technical.TrueRangeRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) TrueRangeSeries(ctx context.Context, query technical.TrueRangeQuery) (api.Series[technical.TrueRangeRow], error) {
+	return querySeries[technical.TrueRangeRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) UltimateOscillator(ctx context.Context, query technical.UltimateOscillatorQuery) ([]technical.UltimateOscillatorRow, error) {
 	return queryRows[technical.UltimateOscillatorRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) UltimateOscillatorSeq(ctx context.Context, query technical.UltimateOscillatorQuery) iter.Seq2[technical.UltimateOscillatorRow, error] {
+	return queryRowsSeq[technical.UltimateOscillatorRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) UltimateOscillatorSeries(ctx context.Context, query technical.UltimateOscillatorQuery) (api.Series[technical.UltimateOscillatorRow], error) {
+	return querySeries[technical.UltimateOscillatorRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) VolumeWeightedAveragePrice(ctx context.Context, query technical.VolumeWeightedAveragePriceQuery) ([]technical.VolumeWeightedAveragePriceRow, error) {
 	return queryRows[technical.VolumeWeightedAveragePriceRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) VolumeWeightedAveragePriceSeq(ctx context.Context, query technical.VolumeWeightedAveragePriceQuery) iter.Seq2[technical.VolumeWeightedAveragePriceRow, error] {
+	return queryRowsSeq[technical.VolumeWeightedAveragePriceRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) VolumeWeightedAveragePriceSeries(ctx context.Context, query technical.VolumeWeightedAveragePriceQuery) (api.Series[technical.VolumeWeightedAveragePriceRow], error) {
+	return querySeries[technical.VolumeWeightedAveragePriceRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) WeightedMovingAverage(ctx context.Context, query technical.WeightedMovingAverageQuery) ([]technical.WeightedMovingAverageRow, error) {
 	return queryRows[technical.WeightedMovingAverageRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) WeightedMovingAverageSeq(ctx context.Context, query technical.WeightedMovingAverageQuery) iter.Seq2[technical.WeightedMovingAverageRow, error] {
+	return queryRowsSeq[technical.WeightedMovingAverageRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) WeightedMovingAverageSeries(ctx context.Context, query technical.WeightedMovingAverageQuery) (api.Series[technical.WeightedMovingAverageRow], error) {
+	return querySeries[technical.WeightedMovingAverageRow](ctx, (*Client)(f), query)
+}
+
 func (f *TechnicalFunctions) WilliamsR(ctx context.Context, query technical.WilliamsRQuery) ([]technical.WilliamsRRow, error) {
 	return queryRows[technical.WilliamsRRow](ctx, (*Client)(f), query)
 }
 
+func (f *TechnicalFunctions) WilliamsRSeq(ctx context.Context, query technical.WilliamsRQuery) iter.Seq2[technical.WilliamsRRow, error] {
+	return queryRowsSeq[technical.WilliamsRRow](ctx, (*Client)(f), query)
+}
+
+func (f *TechnicalFunctions) WilliamsRSeries(ctx context.Context, query technical.WilliamsRQuery) (api.Series[technical.WilliamsRRow], error) {
+	return querySeries[technical.WilliamsRRow](ctx, (*Client)(f), query)
+}
+
 func (f *TimeSeriesFunctions) GlobalQuote(ctx context.Context, query timeseries.GlobalQuoteQuery) ([]timeseries.GlobalQuoteRow, error) {
 	return queryRows[timeseries.GlobalQuoteRow](ctx, (*Client)(f), query)
 }
 
+func (f *TimeSeriesFunctions) GlobalQuoteSeq(ctx context.Context, query timeseries.GlobalQuoteQuery) iter.Seq2[timeseries.GlobalQuoteRow, error] {
+	return queryRowsSeq[timeseries.GlobalQuoteRow](ctx, (*Client)(f), query)
+}
+
+func (f *TimeSeriesFunctions) GlobalQuoteSeries(ctx context.Context, query timeseries.GlobalQuoteQuery) (api.Series[timeseries.GlobalQuoteRow], error) {
+	return querySeries[timeseries.GlobalQuoteRow](ctx, (*Client)(f), query)
+}
+
+func (f *TimeSeriesFunctions) RealtimeBulkQuotes(ctx context.Context, query timeseries.RealtimeBulkQuotesQuery) ([]timeseries.RealtimeBulkQuotesRow, error) {
+	return queryRows[timeseries.RealtimeBulkQuotesRow](ctx, (*Client)(f), query)
+}
+
+func (f *TimeSeriesFunctions) RealtimeBulkQuotesSeq(ctx context.Context, query timeseries.RealtimeBulkQuotesQuery) iter.Seq2[timeseries.RealtimeBulkQuotesRow, error] {
+	return queryRowsSeq[timeseries.RealtimeBulkQuotesRow](ctx, (*Client)(f), query)
+}
+
+func (f *TimeSeriesFunctions) RealtimeBulkQuotesSeries(ctx context.Context, query timeseries.RealtimeBulkQuotesQuery) (api.Series[timeseries.RealtimeBulkQuotesRow], error) {
+	return querySeries[timeseries.RealtimeBulkQuotesRow](ctx, (*Client)(f), query)
+}
+
 func (f *TimeSeriesFunctions) SymbolSearch(ctx context.Context, query timeseries.SymbolSearchQuery) ([]timeseries.SymbolSearchRow, error) {
 	return queryRows[timeseries.SymbolSearchRow](ctx, (*Client)(f), query)
 }
 
+func (f *TimeSeriesFunctions) SymbolSearchSeq(ctx context.Context, query timeseries.SymbolSearchQuery) iter.Seq2[timeseries.SymbolSearchRow, error] {
+	return queryRowsSeq[timeseries.SymbolSearchRow](ctx, (*Client)(f), query)
+}
+
+func (f *TimeSeriesFunctions) SymbolSearchSeries(ctx context.Context, query timeseries.SymbolSearchQuery) (api.Series[timeseries.SymbolSearchRow], error) {
+	return querySeries[timeseries.SymbolSearchRow](ctx, (*Client)(f), query)
+}
+
 func (f *TimeSeriesFunctions) Daily(ctx context.Context, query timeseries.DailyQuery) ([]timeseries.DailyRow, error) {
 	return queryRows[timeseries.DailyRow](ctx, (*Client)(f), query)
 }
 
+func (f *TimeSeriesFunctions) DailySeq(ctx context.Context, query timeseries.DailyQuery) iter.Seq2[timeseries.DailyRow, error] {
+	return queryRowsSeq[timeseries.DailyRow](ctx, (*Client)(f), query)
+}
+
+func (f *TimeSeriesFunctions) DailySeries(ctx context.Context, query timeseries.DailyQuery) (api.Series[timeseries.DailyRow], error) {
+	return querySeries[timeseries.DailyRow](ctx, (*Client)(f), query)
+}
+
 func (f *TimeSeriesFunctions) DailyAdjusted(ctx context.Context, query timeseries.DailyAdjustedQuery) ([]timeseries.DailyAdjustedRow, error) {
 	return queryRows[timeseries.DailyAdjustedRow](ctx, (*Client)(f), query)
 }
 
+func (f *TimeSeriesFunctions) DailyAdjustedSeq(ctx context.Context, query timeseries.DailyAdjustedQuery) iter.Seq2[timeseries.DailyAdjustedRow, error] {
+	return queryRowsSeq[timeseries.DailyAdjustedRow](ctx, (*Client)(f), query)
+}
+
+func (f *TimeSeriesFunctions) DailyAdjustedSeries(ctx context.Context, query timeseries.DailyAdjustedQuery) (api.Series[timeseries.DailyAdjustedRow], error) {
+	return querySeries[timeseries.DailyAdjustedRow](ctx, (*Client)(f), query)
+}
+
 func (f *TimeSeriesFunctions) Intraday(ctx context.Context, query timeseries.IntradayQuery) ([]timeseries.IntradayRow, error) {
 	return queryRows[timeseries.IntradayRow](ctx, (*Client)(f), query)
 }
 
+func (f *TimeSeriesFunctions) IntradaySeq(ctx context.Context, query timeseries.IntradayQuery) iter.Seq2[timeseries.IntradayRow, error] {
+	return queryRowsSeq[timeseries.IntradayRow](ctx, (*Client)(f), query)
+}
+
+func (f *TimeSeriesFunctions) IntradaySeries(ctx context.Context, query timeseries.IntradayQuery) (api.Series[timeseries.IntradayRow], error) {
+	return querySeries[timeseries.IntradayRow](ctx, (*Client)(f), query)
+}
+
 func (f *TimeSeriesFunctions) Monthly(ctx context.Context, query timeseries.MonthlyQuery) ([]timeseries.MonthlyRow, error) {
 	return queryRows[timeseries.MonthlyRow](ctx, (*Client)(f), query)
 }
 
+func (f *TimeSeriesFunctions) MonthlySeq(ctx context.Context, query timeseries.MonthlyQuery) iter.Seq2[timeseries.MonthlyRow, error] {
+	return queryRowsSeq[timeseries.MonthlyRow](ctx, (*Client)(f), query)
+}
+
+func (f *TimeSeriesFunctions) MonthlySeries(ctx context.Context, query timeseries.MonthlyQuery) (api.Series[timeseries.MonthlyRow], error) {
+	return querySeries[timeseries.MonthlyRow](ctx, (*Client)(f), query)
+}
+
 func (f *TimeSeriesFunctions) MonthlyAdjusted(ctx context.Context, query timeseries.MonthlyAdjustedQuery) ([]timeseries.MonthlyAdjustedRow, error) {
 	return queryRows[timeseries.MonthlyAdjustedRow](ctx, (*Client)(f), query)
 }
 
+func (f *TimeSeriesFunctions) MonthlyAdjustedSeq(ctx context.Context, query timeseries.MonthlyAdjustedQuery) iter.Seq2[timeseries.MonthlyAdjustedRow, error] {
+	return queryRowsSeq[timeseries.MonthlyAdjustedRow](ctx, (*Client)(f), query)
+}
+
+func (f *TimeSeriesFunctions) MonthlyAdjustedSeries(ctx context.Context, query timeseries.MonthlyAdjustedQuery) (api.Series[timeseries.MonthlyAdjustedRow], error) {
+	return querySeries[timeseries.MonthlyAdjustedRow](ctx, (*Client)(f), query)
+}
+
 func (f *TimeSeriesFunctions) Weekly(ctx context.Context, query timeseries.WeeklyQuery) ([]timeseries.WeeklyRow, error) {
 	return queryRows[timeseries.WeeklyRow](ctx, (*Client)(f), query)
 }
 
+func (f *TimeSeriesFunctions) WeeklySeq(ctx context.Context, query timeseries.WeeklyQuery) iter.Seq2[timeseries.WeeklyRow, error] {
+	return queryRowsSeq[timeseries.WeeklyRow](ctx, (*Client)(f), query)
+}
+
+func (f *TimeSeriesFunctions) WeeklySeries(ctx context.Context, query timeseries.WeeklyQuery) (api.Series[timeseries.WeeklyRow], error) {
+	return querySeries[timeseries.WeeklyRow](ctx, (*Client)(f), query)
+}
+
 func (f *TimeSeriesFunctions) WeeklyAdjusted(ctx context.Context, query timeseries.WeeklyAdjustedQuery) ([]timeseries.WeeklyAdjustedRow, error) {
 	return queryRows[timeseries.WeeklyAdjustedRow](ctx, (*Client)(f), query)
 }
+
+func (f *TimeSeriesFunctions) WeeklyAdjustedSeq(ctx context.Context, query timeseries.WeeklyAdjustedQuery) iter.Seq2[timeseries.WeeklyAdjustedRow, error] {
+	return queryRowsSeq[timeseries.WeeklyAdjustedRow](ctx, (*Client)(f), query)
+}
+
+func (f *TimeSeriesFunctions) WeeklyAdjustedSeries(ctx context.Context, query timeseries.WeeklyAdjustedQuery) (api.Series[timeseries.WeeklyAdjustedRow], error) {
+	return querySeries[timeseries.WeeklyAdjustedRow](ctx, (*Client)(f), query)
+}
@@ -0,0 +1,273 @@
+package indicator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Compute evaluates the technical function named by the "function"
+// parameter using the AlphaVantage parameter names (time_period,
+// series_type, fastperiod, …). Parameters that are not set use the
+// AlphaVantage defaults. Parameters that only matter to the API, such as
+// symbol, interval and apikey, are ignored.
+func Compute(series Series, params url.Values) (Table, error) {
+	p := parameters{values: params}
+	function := params.Get("function")
+	switch function {
+	case "MACD", "MACDEXT", "RSI", "STOCHRSI", "APO", "PPO", "MOM", "CMO", "ROC", "ROCR", "TRIX",
+		"SMA", "EMA", "WMA", "DEMA", "TEMA", "TRIMA", "KAMA", "T3", "MAMA", "BBANDS", "MIDPOINT",
+		"HT_TRENDLINE", "HT_SINE", "HT_TRENDMODE", "HT_DCPERIOD", "HT_DCPHASE", "HT_PHASOR":
+		in, err := series.Field(params.Get("series_type"))
+		if err != nil {
+			return Table{}, fmt.Errorf("%s: %w", function, err)
+		}
+		p.in = in
+	}
+	high, low, close, volume := series.High, series.Low, series.Close, series.Volume
+
+	var (
+		columns []string
+		values  [][]float64
+	)
+	single := func(v []float64) {
+		columns, values = []string{function}, [][]float64{v}
+	}
+	switch function {
+	case "SMA":
+		single(SMA(p.in, p.int("time_period", 30)))
+	case "EMA":
+		single(EMA(p.in, p.int("time_period", 30)))
+	case "WMA":
+		single(WMA(p.in, p.int("time_period", 30)))
+	case "DEMA":
+		single(DEMA(p.in, p.int("time_period", 30)))
+	case "TEMA":
+		single(TEMA(p.in, p.int("time_period", 30)))
+	case "TRIMA":
+		single(TRIMA(p.in, p.int("time_period", 30)))
+	case "KAMA":
+		single(KAMA(p.in, p.int("time_period", 30)))
+	case "T3":
+		single(T3(p.in, p.int("time_period", 5), p.float("vfactor", defaultT3VFactor)))
+	case "MAMA":
+		mama, fama := MAMA(p.in, p.float("fastlimit", defaultMAMALimit), p.float("slowlimit", defaultMAMALimit))
+		columns, values = []string{"FAMA", "MAMA"}, [][]float64{fama, mama}
+	case "VWAP":
+		single(VWAP(series))
+	case "MACD":
+		macd, signal, hist := MACD(p.in, p.int("fastperiod", 12), p.int("slowperiod", 26), p.int("signalperiod", 9))
+		columns, values = []string{"MACD", "MACD_Hist", "MACD_Signal"}, [][]float64{macd, hist, signal}
+	case "MACDEXT":
+		macd, signal, hist := MACDExt(p.in,
+			p.int("fastperiod", 12), p.maType("fastmatype"),
+			p.int("slowperiod", 26), p.maType("slowmatype"),
+			p.int("signalperiod", 9), p.maType("signalmatype"))
+		columns, values = []string{"MACD", "MACD_Hist", "MACD_Signal"}, [][]float64{macd, hist, signal}
+	case "STOCH":
+		slowK, slowD := Stoch(high, low, close,
+			p.int("fastkperiod", 5),
+			p.int("slowkperiod", 3), p.maType("slowkmatype"),
+			p.int("slowdperiod", 3), p.maType("slowdmatype"))
+		columns, values = []string{"SlowD", "SlowK"}, [][]float64{slowD, slowK}
+	case "STOCHF":
+		fastK, fastD := StochF(high, low, close, p.int("fastkperiod", 5), p.int("fastdperiod", 3), p.maType("fastdmatype"))
+		columns, values = []string{"FastD", "FastK"}, [][]float64{fastD, fastK}
+	case "RSI":
+		single(RSI(p.in, p.int("time_period", 14)))
+	case "STOCHRSI":
+		fastK, fastD := StochRSI(p.in, p.int("time_period", 14), p.int("fastkperiod", 5), p.int("fastdperiod", 3), p.maType("fastdmatype"))
+		columns, values = []string{"FastD", "FastK"}, [][]float64{fastD, fastK}
+	case "WILLR":
+		single(WillR(high, low, close, p.int("time_period", 14)))
+	case "ADX":
+		single(ADX(high, low, close, p.int("time_period", 14)))
+	case "ADXR":
+		single(ADXR(high, low, close, p.int("time_period", 14)))
+	case "APO":
+		single(APO(p.in, p.int("fastperiod", 12), p.int("slowperiod", 26), p.maType("matype")))
+	case "PPO":
+		single(PPO(p.in, p.int("fastperiod", 12), p.int("slowperiod", 26), p.maType("matype")))
+	case "MOM":
+		single(Mom(p.in, p.int("time_period", 10)))
+	case "BOP":
+		single(BOP(series.Open, high, low, close))
+	case "CCI":
+		single(CCI(high, low, close, p.int("time_period", 14)))
+	case "CMO":
+		single(CMO(p.in, p.int("time_period", 14)))
+	case "ROC":
+		single(ROC(p.in, p.int("time_period", 10)))
+	case "ROCR":
+		single(ROCR(p.in, p.int("time_period", 10)))
+	case "AROON":
+		down, up := Aroon(high, low, p.int("time_period", 14))
+		columns, values = []string{"Aroon Down", "Aroon Up"}, [][]float64{down, up}
+	case "AROONOSC":
+		single(AroonOsc(high, low, p.int("time_period", 14)))
+	case "MFI":
+		single(MFI(high, low, close, volume, p.int("time_period", 14)))
+	case "TRIX":
+		single(TRIX(p.in, p.int("time_period", 30)))
+	case "ULTOSC":
+		single(UltOsc(high, low, close, p.int("timeperiod1", 7), p.int("timeperiod2", 14), p.int("timeperiod3", 28)))
+	case "DX":
+		single(DX(high, low, close, p.int("time_period", 14)))
+	case "MINUS_DI":
+		single(MinusDI(high, low, close, p.int("time_period", 14)))
+	case "PLUS_DI":
+		single(PlusDI(high, low, close, p.int("time_period", 14)))
+	case "MINUS_DM":
+		single(MinusDM(high, low, p.int("time_period", 14)))
+	case "PLUS_DM":
+		single(PlusDM(high, low, p.int("time_period", 14)))
+	case "BBANDS":
+		lower, middle, upper := BBands(p.in, p.int("time_period", 5), p.float("nbdevup", 2), p.float("nbdevdn", 2), p.maType("matype"))
+		columns, values = []string{"Real Lower Band", "Real Middle Band", "Real Upper Band"}, [][]float64{lower, middle, upper}
+	case "MIDPOINT":
+		single(MidPoint(p.in, p.int("time_period", 14)))
+	case "MIDPRICE":
+		single(MidPrice(high, low, p.int("time_period", 14)))
+	case "SAR":
+		single(SAR(high, low, p.float("acceleration", 0.01), p.float("maximum", 0.2)))
+	case "TRANGE":
+		single(TRange(high, low, close))
+	case "ATR":
+		single(ATR(high, low, close, p.int("time_period", 14)))
+	case "NATR":
+		single(NATR(high, low, close, p.int("time_period", 14)))
+	case "AD":
+		columns, values = []string{"Chaikin A/D"}, [][]float64{AD(high, low, close, volume)}
+	case "ADOSC":
+		single(ADOSC(high, low, close, volume, p.int("fastperiod", 3), p.int("slowperiod", 10)))
+	case "OBV":
+		single(OBV(close, volume))
+	case "HT_TRENDLINE":
+		single(HTTrendline(p.in))
+	case "HT_SINE":
+		sine, leadSine := HTSine(p.in)
+		columns, values = []string{"LEAD SINE", "SINE"}, [][]float64{leadSine, sine}
+	case "HT_TRENDMODE":
+		columns, values = []string{"TRENDMODE"}, [][]float64{HTTrendMode(p.in)}
+	case "HT_DCPERIOD":
+		columns, values = []string{"DCPERIOD"}, [][]float64{HTDCPeriod(p.in)}
+	case "HT_DCPHASE":
+		single(HTDCPhase(p.in))
+	case "HT_PHASOR":
+		inPhase, quadrature := HTPhasor(p.in)
+		columns, values = []string{"PHASE", "QUADRATURE"}, [][]float64{inPhase, quadrature}
+	default:
+		return Table{}, fmt.Errorf("unsupported technical function %q", function)
+	}
+	if err := p.err(); err != nil {
+		return Table{}, fmt.Errorf("%s: %w", function, err)
+	}
+	return newTable(function, series.Time, columns, values...), nil
+}
+
+type parameters struct {
+	values url.Values
+	in     []float64
+	errs   []error
+}
+
+func (p *parameters) int(name string, defaultValue int) int {
+	s := p.values.Get(name)
+	if s == "" {
+		return defaultValue
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 {
+		p.errs = append(p.errs, fmt.Errorf("%s must be a positive integer: %q", name, s))
+		return defaultValue
+	}
+	return n
+}
+
+func (p *parameters) float(name string, defaultValue float64) float64 {
+	s := p.values.Get(name)
+	if s == "" {
+		return defaultValue
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		p.errs = append(p.errs, fmt.Errorf("%s must be a number: %q", name, s))
+		return defaultValue
+	}
+	return f
+}
+
+func (p *parameters) maType(name string) MAType {
+	s := p.values.Get(name)
+	if s == "" {
+		return MATypeSMA
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < int(MATypeSMA) || n > int(MATypeMAMA) {
+		p.errs = append(p.errs, fmt.Errorf("%s must be an integer between 0 and 8: %q", name, s))
+		return MATypeSMA
+	}
+	return MAType(n)
+}
+
+func (p *parameters) err() error { return errors.Join(p.errs...) }
+
+// Rows copies the table into technical row structs such as
+// technical.SimpleMovingAverageRow, matching fields by their column-name
+// tags. Like the AlphaVantage responses, the rows are ordered from the most
+// recent bar to the oldest.
+func Rows[T any](table Table) ([]T, error) {
+	rowType := reflect.TypeFor[T]()
+	if rowType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("row type %s is not a struct", rowType)
+	}
+	timeField := -1
+	columnFields := make([]int, len(table.Columns))
+	for i := range columnFields {
+		columnFields[i] = -1
+	}
+	for i := range rowType.NumField() {
+		field := rowType.Field(i)
+		name, ok := field.Tag.Lookup("column-name")
+		if !ok {
+			continue
+		}
+		if name == "time" && field.Type == reflect.TypeFor[time.Time]() {
+			timeField = i
+			continue
+		}
+		if c := slices.Index(table.Columns, name); c >= 0 {
+			columnFields[c] = i
+		}
+	}
+	if timeField < 0 {
+		return nil, fmt.Errorf("row type %s has no time field", rowType)
+	}
+	if c := slices.Index(columnFields, -1); c >= 0 {
+		return nil, fmt.Errorf("row type %s has no field for column %q", rowType, table.Columns[c])
+	}
+
+	rows := make([]T, len(table.Time))
+	for i := range rows {
+		j := len(table.Time) - 1 - i
+		row := reflect.ValueOf(&rows[i]).Elem()
+		row.Field(timeField).Set(reflect.ValueOf(table.Time[j]))
+		for c, fieldIndex := range columnFields {
+			field := row.Field(fieldIndex)
+			switch field.Kind() {
+			case reflect.Float32, reflect.Float64:
+				field.SetFloat(table.Values[c][j])
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				field.SetInt(int64(math.Round(table.Values[c][j])))
+			default:
+				return nil, fmt.Errorf("unsupported field type %s for column %q", field.Type(), table.Columns[c])
+			}
+		}
+	}
+	return rows, nil
+}
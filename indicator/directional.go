@@ -0,0 +1,165 @@
+package indicator
+
+import "math"
+
+// PlusDM is Wilder's smoothed plus directional movement.
+func PlusDM(high, low []float64, period int) []float64 {
+	plus, _ := directionalMovement(high, low)
+	return wilderSum(plus, period, period-1)
+}
+
+// MinusDM is Wilder's smoothed minus directional movement.
+func MinusDM(high, low []float64, period int) []float64 {
+	_, minus := directionalMovement(high, low)
+	return wilderSum(minus, period, period-1)
+}
+
+// PlusDI is the plus directional indicator.
+func PlusDI(high, low, close []float64, period int) []float64 {
+	plus, _ := directionalMovement(high, low)
+	return ratio(wilderSum(plus, period, period), wilderSum(trueRange(high, low, close), period, period))
+}
+
+// MinusDI is the minus directional indicator.
+func MinusDI(high, low, close []float64, period int) []float64 {
+	_, minus := directionalMovement(high, low)
+	return ratio(wilderSum(minus, period, period), wilderSum(trueRange(high, low, close), period, period))
+}
+
+// DX is the directional movement index.
+func DX(high, low, close []float64, period int) []float64 {
+	plus, minus := PlusDI(high, low, close, period), MinusDI(high, low, close, period)
+	out := nans(len(close))
+	for i := range out {
+		if math.IsNaN(plus[i]) {
+			continue
+		}
+		if sum := plus[i] + minus[i]; !isZero(sum) {
+			out[i] = 100 * math.Abs(minus[i]-plus[i]) / sum
+		} else {
+			out[i] = 0
+		}
+	}
+	return out
+}
+
+// ADX is the average directional movement index.
+func ADX(high, low, close []float64, period int) []float64 {
+	dx := DX(high, low, close, period)
+	out := nans(len(close))
+	start := firstValid(dx)
+	if period < 1 || start+period > len(dx) {
+		return out
+	}
+	p := float64(period)
+	prev := 0.0
+	for i := start; i < start+period; i++ {
+		prev += dx[i]
+	}
+	prev /= p
+	out[start+period-1] = prev
+	for i := start + period; i < len(dx); i++ {
+		prev = (prev*(p-1) + dx[i]) / p
+		out[i] = prev
+	}
+	return out
+}
+
+// ADXR is the average directional movement index rating.
+func ADXR(high, low, close []float64, period int) []float64 {
+	adx := ADX(high, low, close, period)
+	out := nans(len(close))
+	for i := period - 1; i < len(out); i++ {
+		out[i] = (adx[i] + adx[i-period+1]) / 2
+	}
+	return out
+}
+
+// Aroon returns the Aroon down and up lines.
+func Aroon(high, low []float64, period int) (down, up []float64) {
+	down, up = nans(len(high)), nans(len(high))
+	p := float64(period)
+	for i := period; i < len(high); i++ {
+		highest, lowest := i-period, i-period
+		for j := i - period + 1; j <= i; j++ {
+			if high[j] >= high[highest] {
+				highest = j
+			}
+			if low[j] <= low[lowest] {
+				lowest = j
+			}
+		}
+		up[i] = 100 * (p - float64(i-highest)) / p
+		down[i] = 100 * (p - float64(i-lowest)) / p
+	}
+	return down, up
+}
+
+// AroonOsc is the Aroon up line minus the Aroon down line.
+func AroonOsc(high, low []float64, period int) []float64 {
+	down, up := Aroon(high, low, period)
+	out := nans(len(high))
+	for i := range out {
+		out[i] = up[i] - down[i]
+	}
+	return out
+}
+
+// directionalMovement returns the raw one bar plus and minus movements. The
+// first value is NaN.
+func directionalMovement(high, low []float64) (plus, minus []float64) {
+	plus, minus = nans(len(high)), nans(len(high))
+	for i := 1; i < len(high); i++ {
+		up, down := high[i]-high[i-1], low[i-1]-low[i]
+		plus[i], minus[i] = 0, 0
+		if up > 0 && up > down {
+			plus[i] = up
+		} else if down > 0 && down > up {
+			minus[i] = down
+		}
+	}
+	return plus, minus
+}
+
+// wilderSum sums the first period-1 values after the NaN at index 0 and
+// then applies Wilder smoothing (sum - sum/period + value). The first output
+// is at index start, which is period-1 for the movement indicators and
+// period when one smoothing step is applied before the first output.
+func wilderSum(in []float64, period, start int) []float64 {
+	out := nans(len(in))
+	if period <= 1 {
+		copy(out, in)
+		return out
+	}
+	if start >= len(in) {
+		return out
+	}
+	p := float64(period)
+	sum := 0.0
+	for i := 1; i < period; i++ {
+		sum += in[i]
+	}
+	for i := period; i <= start; i++ {
+		sum = sum - sum/p + in[i]
+	}
+	out[start] = sum
+	for i := start + 1; i < len(in); i++ {
+		sum = sum - sum/p + in[i]
+		out[i] = sum
+	}
+	return out
+}
+
+func ratio(numerator, denominator []float64) []float64 {
+	out := nans(len(numerator))
+	for i := range out {
+		switch {
+		case math.IsNaN(numerator[i]) || math.IsNaN(denominator[i]):
+		case isZero(denominator[i]):
+			out[i] = 0
+		default:
+			out[i] = 100 * numerator[i] / denominator[i]
+		}
+	}
+	return out
+}
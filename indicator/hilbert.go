@@ -0,0 +1,323 @@
+package indicator
+
+import "math"
+
+// The Hilbert transform indicators follow John Ehlers' "Rocket Science for
+// Traders" as implemented by TA-Lib. They smooth the price with a four bar
+// weighted average, measure the dominant cycle period and derive phase,
+// sine wave and trend information from it.
+
+const (
+	// hilbertLookback is the lookback of HT_DCPERIOD, HT_PHASOR and MAMA.
+	hilbertLookback = 32
+	// dominantCycleLookback is the lookback of HT_DCPHASE, HT_SINE,
+	// HT_TRENDLINE and HT_TRENDMODE which need a longer price history.
+	dominantCycleLookback = 63
+
+	smoothPriceSize = 50
+	rad2Deg         = 180 / math.Pi
+	deg2Rad         = math.Pi / 180
+)
+
+// HTDCPeriod is the Hilbert transform dominant cycle period.
+func HTDCPeriod(in []float64) []float64 {
+	out := nans(len(in))
+	h, today := newHilbert(in, 9)
+	for ; today < len(in); today++ {
+		h.step(today)
+		if today >= h.start+hilbertLookback {
+			out[today] = h.smoothPeriod
+		}
+	}
+	return out
+}
+
+// HTPhasor returns the in-phase and quadrature components of the Hilbert
+// transform.
+func HTPhasor(in []float64) (inPhase, quadrature []float64) {
+	inPhase, quadrature = nans(len(in)), nans(len(in))
+	h, today := newHilbert(in, 9)
+	for ; today < len(in); today++ {
+		h.step(today)
+		if today >= h.start+hilbertLookback {
+			inPhase[today], quadrature[today] = h.i1, h.q1
+		}
+	}
+	return inPhase, quadrature
+}
+
+// HTDCPhase is the Hilbert transform dominant cycle phase in degrees.
+func HTDCPhase(in []float64) []float64 {
+	out := nans(len(in))
+	h, today := newHilbert(in, 34)
+	for ; today < len(in); today++ {
+		h.step(today)
+		h.updatePhase()
+		if today >= h.start+dominantCycleLookback {
+			out[today] = h.phase
+		}
+	}
+	return out
+}
+
+// HTSine returns the Hilbert transform sine wave and the sine wave advanced
+// by 45 degrees.
+func HTSine(in []float64) (sine, leadSine []float64) {
+	sine, leadSine = nans(len(in)), nans(len(in))
+	h, today := newHilbert(in, 34)
+	for ; today < len(in); today++ {
+		h.step(today)
+		h.updatePhase()
+		if today >= h.start+dominantCycleLookback {
+			sine[today] = math.Sin(h.phase * deg2Rad)
+			leadSine[today] = math.Sin((h.phase + 45) * deg2Rad)
+		}
+	}
+	return sine, leadSine
+}
+
+// HTTrendline is the Hilbert transform instantaneous trendline.
+func HTTrendline(in []float64) []float64 {
+	out := nans(len(in))
+	h, today := newHilbert(in, 34)
+	for ; today < len(in); today++ {
+		h.step(today)
+		h.updateTrendline(today)
+		if today >= h.start+dominantCycleLookback {
+			out[today] = h.trendline
+		}
+	}
+	return out
+}
+
+// HTTrendMode is 1 when the Hilbert transform considers the market trending
+// and 0 when it is in a cycle mode.
+func HTTrendMode(in []float64) []float64 {
+	out := nans(len(in))
+	h, today := newHilbert(in, 34)
+	var sine, leadSine float64
+	daysInTrend := 0
+	for ; today < len(in); today++ {
+		h.step(today)
+		prevPhase := h.phase
+		h.updatePhase()
+		prevSine, prevLeadSine := sine, leadSine
+		sine, leadSine = math.Sin(h.phase*deg2Rad), math.Sin((h.phase+45)*deg2Rad)
+		h.updateTrendline(today)
+
+		trend := 1.0
+		if (sine > leadSine && prevSine <= prevLeadSine) || (sine < leadSine && prevSine >= prevLeadSine) {
+			daysInTrend = 0
+			trend = 0
+		}
+		daysInTrend++
+		if float64(daysInTrend) < 0.5*h.smoothPeriod {
+			trend = 0
+		}
+		if change := h.phase - prevPhase; h.smoothPeriod != 0 && change > 0.67*360/h.smoothPeriod && change < 1.5*360/h.smoothPeriod {
+			trend = 0
+		}
+		if h.trendline != 0 && math.Abs((h.smoothPrice[h.smoothPriceIdx]-h.trendline)/h.trendline) >= 0.015 {
+			trend = 1
+		}
+		if today >= h.start+dominantCycleLookback {
+			out[today] = trend
+		}
+	}
+	return out
+}
+
+const defaultMAMALimit = 0.01
+
+// MAMA returns the MESA adaptive moving average and the following adaptive
+// moving average. The smoothing factor adapts to the rate of change of the
+// Hilbert transform phase between slowLimit and fastLimit.
+func MAMA(in []float64, fastLimit, slowLimit float64) (mama, fama []float64) {
+	mama, fama = nans(len(in)), nans(len(in))
+	h, today := newHilbert(in, 9)
+	var prevPhase, m, f float64
+	for ; today < len(in); today++ {
+		h.step(today)
+		phase := 0.0
+		if h.i1 != 0 {
+			phase = math.Atan(h.q1/h.i1) * rad2Deg
+		}
+		delta := max(prevPhase-phase, 1)
+		prevPhase = phase
+		alpha := fastLimit
+		if delta > 1 {
+			alpha = max(fastLimit/delta, slowLimit)
+		}
+		m = alpha*in[today] + (1-alpha)*m
+		f = alpha/2*m + (1-alpha/2)*f
+		if today >= h.start+hilbertLookback {
+			mama[today], fama[today] = m, f
+		}
+	}
+	return mama, fama
+}
+
+type hilbert struct {
+	in    []float64
+	start int
+
+	// four bar weighted moving average of the price
+	wmaSub, wmaSum, trailingValue float64
+	trailingIdx                   int
+	smoothed                      float64
+
+	idx                               int
+	detrender, q1Transform, jI, jQ    hilbertTransform
+	i1OddPrev2, i1OddPrev3            float64
+	i1EvenPrev2, i1EvenPrev3          float64
+	prevI2, prevQ2, re, im, period    float64
+	smoothPeriod                      float64
+	i1, q1                            float64
+	smoothPrice                       [smoothPriceSize]float64
+	smoothPriceIdx                    int
+	phase                             float64
+	trendline, trend1, trend2, trend3 float64
+}
+
+// hilbertTransform holds the state of one Hilbert transform. Even and odd
+// bars are filtered separately as in TA-Lib.
+type hilbertTransform struct {
+	odd, even                   [3]float64
+	prevOdd, prevEven           float64
+	prevInputOdd, prevInputEven float64
+}
+
+// newHilbert primes the price smoothing with warmup bars and returns the
+// index of the first bar to step.
+func newHilbert(in []float64, warmup int) (*hilbert, int) {
+	start := firstValid(in)
+	h := &hilbert{in: in, start: start, trailingIdx: start}
+	if start+3 > len(in) {
+		return h, len(in)
+	}
+	for i, weight := range []float64{1, 2, 3} {
+		h.wmaSub += in[start+i]
+		h.wmaSum += in[start+i] * weight
+	}
+	today := start + 3
+	for ; warmup > 0 && today < len(in); warmup-- {
+		h.smoothPrice4(in[today])
+		today++
+	}
+	return h, today
+}
+
+func (h *hilbert) smoothPrice4(price float64) {
+	h.wmaSub += price - h.trailingValue
+	h.wmaSum += price * 4
+	h.trailingValue = h.in[h.trailingIdx]
+	h.trailingIdx++
+	h.smoothed = h.wmaSum * 0.1
+	h.wmaSum -= h.wmaSub
+}
+
+func (h *hilbert) step(today int) {
+	const a, b = 0.0962, 0.5769
+	adjustedPrevPeriod := 0.075*h.period + 0.54
+	h.smoothPrice4(h.in[today])
+	h.smoothPriceIdx = (h.smoothPriceIdx + 1) % smoothPriceSize
+	h.smoothPrice[h.smoothPriceIdx] = h.smoothed
+
+	transform := func(t *hilbertTransform, input float64, even bool) float64 {
+		v := a * input
+		if even {
+			out := v - t.even[h.idx] - t.prevEven
+			t.even[h.idx] = v
+			t.prevEven = b * t.prevInputEven
+			t.prevInputEven = input
+			return (out + t.prevEven) * adjustedPrevPeriod
+		}
+		out := v - t.odd[h.idx] - t.prevOdd
+		t.odd[h.idx] = v
+		t.prevOdd = b * t.prevInputOdd
+		t.prevInputOdd = input
+		return (out + t.prevOdd) * adjustedPrevPeriod
+	}
+
+	var i2, q2 float64
+	if (today-h.start)%2 == 0 {
+		detrender := transform(&h.detrender, h.smoothed, true)
+		h.q1 = transform(&h.q1Transform, detrender, true)
+		jI := transform(&h.jI, h.i1EvenPrev3, true)
+		jQ := transform(&h.jQ, h.q1, true)
+		h.idx = (h.idx + 1) % 3
+		h.i1 = h.i1EvenPrev3
+		q2 = 0.2*(h.q1+jI) + 0.8*h.prevQ2
+		i2 = 0.2*(h.i1-jQ) + 0.8*h.prevI2
+		h.i1OddPrev3, h.i1OddPrev2 = h.i1OddPrev2, detrender
+	} else {
+		detrender := transform(&h.detrender, h.smoothed, false)
+		h.q1 = transform(&h.q1Transform, detrender, false)
+		jI := transform(&h.jI, h.i1OddPrev3, false)
+		jQ := transform(&h.jQ, h.q1, false)
+		h.i1 = h.i1OddPrev3
+		q2 = 0.2*(h.q1+jI) + 0.8*h.prevQ2
+		i2 = 0.2*(h.i1-jQ) + 0.8*h.prevI2
+		h.i1EvenPrev3, h.i1EvenPrev2 = h.i1EvenPrev2, detrender
+	}
+
+	h.re = 0.2*(i2*h.prevI2+q2*h.prevQ2) + 0.8*h.re
+	h.im = 0.2*(i2*h.prevQ2-q2*h.prevI2) + 0.8*h.im
+	h.prevQ2, h.prevI2 = q2, i2
+	prevPeriod := h.period
+	if h.im != 0 && h.re != 0 {
+		h.period = 360 / (math.Atan(h.im/h.re) * rad2Deg)
+	}
+	h.period = min(h.period, 1.5*prevPeriod)
+	h.period = max(h.period, 0.67*prevPeriod)
+	h.period = min(max(h.period, 6), 50)
+	h.period = 0.2*h.period + 0.8*prevPeriod
+	h.smoothPeriod = 0.33*h.period + 0.67*h.smoothPeriod
+}
+
+// updatePhase computes the dominant cycle phase from the smoothed prices of
+// the last dominant cycle period.
+func (h *hilbert) updatePhase() {
+	periodInt := int(h.smoothPeriod + 0.5)
+	var realPart, imagPart float64
+	idx := h.smoothPriceIdx
+	for i := range periodInt {
+		angle := float64(i) * 2 * math.Pi / float64(periodInt)
+		realPart += math.Sin(angle) * h.smoothPrice[idx]
+		imagPart += math.Cos(angle) * h.smoothPrice[idx]
+		idx = (idx + smoothPriceSize - 1) % smoothPriceSize
+	}
+	if math.Abs(imagPart) > 0 {
+		h.phase = math.Atan(realPart/imagPart) * rad2Deg
+	} else if realPart < 0 {
+		h.phase -= 90
+	} else if realPart > 0 {
+		h.phase += 90
+	}
+	h.phase += 90
+	// compensate for the one bar lag of the weighted moving average
+	h.phase += 360 / h.smoothPeriod
+	if imagPart < 0 {
+		h.phase += 180
+	}
+	if h.phase > 315 {
+		h.phase -= 360
+	}
+}
+
+// updateTrendline averages the price over the dominant cycle period and
+// smooths the averages with a four bar weighted average.
+func (h *hilbert) updateTrendline(today int) {
+	periodInt := int(h.smoothPeriod + 0.5)
+	sum, n := 0.0, 0
+	for i := today; i > today-periodInt && i >= h.start; i-- {
+		sum += h.in[i]
+		n++
+	}
+	average := 0.0
+	if n > 0 {
+		average = sum / float64(periodInt)
+	}
+	h.trendline = (4*average + 3*h.trend1 + 2*h.trend2 + h.trend3) / 10
+	h.trend3, h.trend2, h.trend1 = h.trend2, h.trend1, average
+}
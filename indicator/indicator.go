@@ -0,0 +1,209 @@
+// Package indicator computes the AlphaVantage technical indicators locally.
+//
+// The functions follow TA-Lib, which AlphaVantage uses to serve the
+// technical_* endpoints: outputs have the same length as the input and the
+// lookback region (the bars before an indicator has enough history) is NaN.
+//
+// Compute accepts the same query parameters as the technical endpoints, so a
+// query built with the technical package can be evaluated against locally
+// stored prices:
+//
+//	q := technical.QuerySimpleMovingAverage(apiKey, "IBM", "weekly", "open").TimePeriod("10")
+//	table, err := indicator.Compute(series, url.Values(q))
+//
+// AlphaVantage computes indicators from dividend and split adjusted prices;
+// build the Series from adjusted rows to reproduce its values.
+package indicator
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+)
+
+// Series holds OHLCV bars in chronological order.
+type Series struct {
+	Time   []time.Time
+	Open   []float64
+	High   []float64
+	Low    []float64
+	Close  []float64
+	Volume []float64
+}
+
+// Bar is a single OHLCV observation.
+type Bar struct {
+	Time   time.Time
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// NewSeries sorts bars by time and splits them into columns.
+func NewSeries(bars []Bar) Series {
+	bars = slices.Clone(bars)
+	slices.SortStableFunc(bars, func(a, b Bar) int { return a.Time.Compare(b.Time) })
+	s := Series{
+		Time:   make([]time.Time, len(bars)),
+		Open:   make([]float64, len(bars)),
+		High:   make([]float64, len(bars)),
+		Low:    make([]float64, len(bars)),
+		Close:  make([]float64, len(bars)),
+		Volume: make([]float64, len(bars)),
+	}
+	for i, b := range bars {
+		s.Time[i], s.Open[i], s.High[i], s.Low[i], s.Close[i], s.Volume[i] = b.Time, b.Open, b.High, b.Low, b.Close, b.Volume
+	}
+	return s
+}
+
+// Len returns the number of bars.
+func (s Series) Len() int { return len(s.Time) }
+
+// Bar returns the bar at index i.
+func (s Series) Bar(i int) Bar {
+	return Bar{Time: s.Time[i], Open: s.Open[i], High: s.High[i], Low: s.Low[i], Close: s.Close[i], Volume: s.Volume[i]}
+}
+
+// Field returns the column selected by an AlphaVantage series_type value
+// ("open", "high", "low" or "close").
+func (s Series) Field(seriesType string) ([]float64, error) {
+	switch seriesType {
+	case "open":
+		return s.Open, nil
+	case "high":
+		return s.High, nil
+	case "low":
+		return s.Low, nil
+	case "close":
+		return s.Close, nil
+	}
+	return nil, fmt.Errorf("unknown series_type %q", seriesType)
+}
+
+// FromIntradayRows converts TIME_SERIES_INTRADAY rows.
+func FromIntradayRows(rows []timeseries.IntradayRow) Series {
+	return fromRows(rows, func(r timeseries.IntradayRow) Bar {
+		return Bar{Time: r.TimeStamp, Open: r.Open, High: r.High, Low: r.Low, Close: r.Close, Volume: float64(r.Volume)}
+	})
+}
+
+// FromDailyRows converts TIME_SERIES_DAILY rows. The prices are not adjusted.
+func FromDailyRows(rows []timeseries.DailyRow) Series {
+	return fromRows(rows, func(r timeseries.DailyRow) Bar {
+		return Bar{Time: r.TimeStamp, Open: r.Open, High: r.High, Low: r.Low, Close: r.Close, Volume: float64(r.Volume)}
+	})
+}
+
+// FromDailyAdjustedRows converts TIME_SERIES_DAILY_ADJUSTED rows scaling
+// open, high and low by the ratio of the adjusted close to the close.
+func FromDailyAdjustedRows(rows []timeseries.DailyAdjustedRow) Series {
+	return fromRows(rows, func(r timeseries.DailyAdjustedRow) Bar {
+		return adjustedBar(r.TimeStamp, r.Open, r.High, r.Low, r.Close, r.AdjustedClose, float64(r.Volume))
+	})
+}
+
+// FromWeeklyRows converts TIME_SERIES_WEEKLY rows. The prices are not adjusted.
+func FromWeeklyRows(rows []timeseries.WeeklyRow) Series {
+	return fromRows(rows, func(r timeseries.WeeklyRow) Bar {
+		return Bar{Time: r.TimeStamp, Open: r.Open, High: r.High, Low: r.Low, Close: r.Close, Volume: float64(r.Volume)}
+	})
+}
+
+// FromWeeklyAdjustedRows converts TIME_SERIES_WEEKLY_ADJUSTED rows scaling
+// open, high and low by the ratio of the adjusted close to the close.
+func FromWeeklyAdjustedRows(rows []timeseries.WeeklyAdjustedRow) Series {
+	return fromRows(rows, func(r timeseries.WeeklyAdjustedRow) Bar {
+		return adjustedBar(r.TimeStamp, r.Open, r.High, r.Low, r.Close, r.AdjustedClose, float64(r.Volume))
+	})
+}
+
+// FromMonthlyRows converts TIME_SERIES_MONTHLY rows. The prices are not adjusted.
+func FromMonthlyRows(rows []timeseries.MonthlyRow) Series {
+	return fromRows(rows, func(r timeseries.MonthlyRow) Bar {
+		return Bar{Time: r.TimeStamp, Open: r.Open, High: r.High, Low: r.Low, Close: r.Close, Volume: float64(r.Volume)}
+	})
+}
+
+// FromMonthlyAdjustedRows converts TIME_SERIES_MONTHLY_ADJUSTED rows scaling
+// open, high and low by the ratio of the adjusted close to the close.
+func FromMonthlyAdjustedRows(rows []timeseries.MonthlyAdjustedRow) Series {
+	return fromRows(rows, func(r timeseries.MonthlyAdjustedRow) Bar {
+		return adjustedBar(r.TimeStamp, r.Open, r.High, r.Low, r.Close, r.AdjustedClose, float64(r.Volume))
+	})
+}
+
+func fromRows[T any](rows []T, bar func(T) Bar) Series {
+	bars := make([]Bar, len(rows))
+	for i, r := range rows {
+		bars[i] = bar(r)
+	}
+	return NewSeries(bars)
+}
+
+func adjustedBar(t time.Time, open, high, low, close, adjustedClose, volume float64) Bar {
+	factor := 1.0
+	if close != 0 {
+		factor = adjustedClose / close
+	}
+	return Bar{Time: t, Open: open * factor, High: high * factor, Low: low * factor, Close: adjustedClose, Volume: volume}
+}
+
+// Table is the result of Compute. Columns are named like the AlphaVantage
+// CSV headers and Values[i] holds the column Columns[i] aligned with Time.
+// Bars in the lookback region are omitted.
+type Table struct {
+	Function string
+	Columns  []string
+	Time     []time.Time
+	Values   [][]float64
+}
+
+// Column returns the values for the named column or nil.
+func (t Table) Column(name string) []float64 {
+	i := slices.Index(t.Columns, name)
+	if i < 0 {
+		return nil
+	}
+	return t.Values[i]
+}
+
+func newTable(function string, times []time.Time, columns []string, values ...[]float64) Table {
+	table := Table{Function: function, Columns: columns, Values: make([][]float64, len(values))}
+	start := len(times)
+	for _, v := range values {
+		start = min(start, firstValid(v))
+	}
+	for i := start; i < len(times); i++ {
+		if slices.ContainsFunc(values, func(v []float64) bool { return math.IsNaN(v[i]) }) {
+			continue
+		}
+		table.Time = append(table.Time, times[i])
+		for j, v := range values {
+			table.Values[j] = append(table.Values[j], v[i])
+		}
+	}
+	return table
+}
+
+func firstValid(values []float64) int {
+	for i, v := range values {
+		if !math.IsNaN(v) {
+			return i
+		}
+	}
+	return len(values)
+}
+
+func nans(n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = math.NaN()
+	}
+	return out
+}
@@ -0,0 +1,311 @@
+package indicator_test
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/portfoliotree/alphavantage/api"
+	"github.com/portfoliotree/alphavantage/indicator"
+	"github.com/portfoliotree/alphavantage/query/technical"
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+)
+
+const examplesDir = "../specification/testdata/examples"
+
+// exampleTolerance is the absolute difference allowed between the locally
+// computed values and the recorded AlphaVantage output, which is rounded to
+// four decimals. Indicators with an infinite memory start from the full price
+// history on AlphaVantage but only from the 100 daily (or adjusted weekly)
+// bars in the examples, so they get looser bounds and are only compared on
+// the most recent bars.
+type exampleTolerance struct {
+	delta  float64
+	recent int
+}
+
+var exampleTolerances = map[string]exampleTolerance{
+	"ADOSC":        {delta: 0.5},
+	"ADX":          {delta: 0.005},
+	"ADXR":         {delta: 0.01},
+	"ATR":          {delta: 0.005},
+	"DEMA":         {delta: 0.05},
+	"DX":           {delta: 0.001},
+	"EMA":          {delta: 0.05},
+	"HT_DCPERIOD":  {delta: 0.15, recent: 5},
+	"HT_DCPHASE":   {delta: 0.5, recent: 5},
+	"HT_PHASOR":    {delta: 0.02, recent: 5},
+	"HT_SINE":      {delta: 0.01, recent: 5},
+	"HT_TRENDLINE": {delta: 0.01, recent: 5},
+	"KAMA":         {delta: 0.25},
+	"MACD":         {delta: 0.005},
+	"MAMA":         {delta: 0.001},
+	"MINUS_DI":     {delta: 0.001},
+	"MINUS_DM":     {delta: 0.002},
+	"NATR":         {delta: 0.01},
+	"PLUS_DI":      {delta: 0.001},
+	"PLUS_DM":      {delta: 0.001},
+	"RSI":          {delta: 0.05},
+	"STOCHRSI":     {delta: 0.002},
+	"T3":           {delta: 0.1},
+	"TEMA":         {delta: 0.05},
+}
+
+func TestCompute_examples(t *testing.T) {
+	daily := loadSeries(t, "time_series/TIME_SERIES_DAILY_ADJUSTED_572d0539.csv", indicator.FromDailyAdjustedRows)
+	weekly := weeklyAdjustedSeries(t, daily)
+
+	for _, example := range technicalExamples(t) {
+		t.Run(example.ID, func(t *testing.T) {
+			params := example.params(t)
+			function := params.Get("function")
+			if function == "VWAP" {
+				t.Skip("the intraday example does not start at the beginning of a session; see TestVWAP")
+			}
+			series := daily
+			if params.Get("interval") == "weekly" {
+				series = weekly
+			}
+			table, err := indicator.Compute(series, params)
+			require.NoError(t, err)
+			expected := loadTable(t, example.Path)
+			require.Equal(t, expected.Columns, table.Columns)
+
+			tolerance := exampleTolerances[function]
+			tolerance.delta = cmp.Or(tolerance.delta, 0.0001)
+			tolerance.recent = cmp.Or(tolerance.recent, 15)
+
+			switch function {
+			case "AD", "OBV":
+				// The recorded lines accumulate from 1999 so only the
+				// changes between bars are comparable.
+				expected, table = differences(expected), differences(table)
+			case "MAMA":
+				// With the default limits MAMA is an exponential average with
+				// a factor of 0.01 and FAMA follows MAMA with 0.005. They need
+				// thousands of bars to forget their starting value so compare
+				// what each bar adds instead.
+				expected, table = mamaInnovations(expected), mamaInnovations(table)
+			}
+
+			rows := make(map[time.Time]int, len(table.Time))
+			for i, tm := range table.Time {
+				rows[tm] = i
+			}
+			compared := 0
+			for i, tm := range expected.Time[:min(tolerance.recent, len(expected.Time))] {
+				j, ok := rows[tm]
+				require.True(t, ok, "missing %s", tm)
+				for c, column := range expected.Columns {
+					assert.InDelta(t, expected.Values[c][i], table.Values[c][j], tolerance.delta, "%s at %s", column, tm.Format(time.DateTime))
+				}
+				compared++
+			}
+			assert.NotZero(t, compared)
+		})
+	}
+}
+
+func TestVWAP(t *testing.T) {
+	day := time.Date(2026, 5, 14, 9, 30, 0, 0, time.UTC)
+	series := indicator.NewSeries([]indicator.Bar{
+		{Time: day, High: 11, Low: 9, Close: 10, Volume: 100},
+		{Time: day.Add(15 * time.Minute), High: 13, Low: 11, Close: 12, Volume: 300},
+		{Time: day.AddDate(0, 0, 1), High: 21, Low: 19, Close: 20, Volume: 50},
+	})
+	assert.Equal(t, []float64{10, 11.5, 20}, indicator.VWAP(series))
+}
+
+func TestRows(t *testing.T) {
+	daily := loadSeries(t, "time_series/TIME_SERIES_DAILY_ADJUSTED_572d0539.csv", indicator.FromDailyAdjustedRows)
+	q := technical.QueryBollingerBands("demo", "IBM", "daily", "close").TimePeriod("20").UpperBandStandardDeviationMultiplier("3")
+	table, err := indicator.Compute(daily, url.Values(q))
+	require.NoError(t, err)
+
+	rows, err := indicator.Rows[technical.BollingerBandsRow](table)
+	require.NoError(t, err)
+	require.Len(t, rows, daily.Len()-19)
+	assert.Equal(t, daily.Time[daily.Len()-1], rows[0].Time)
+	assert.Equal(t, daily.Time[19], rows[len(rows)-1].Time)
+	last := len(table.Time) - 1
+	assert.Equal(t, table.Column("Real Upper Band")[last], rows[0].UpperBand)
+	assert.InDelta(t, rows[0].UpperBand-rows[0].MiddleBand, 1.5*(rows[0].MiddleBand-rows[0].LowerBand), 1e-9)
+
+	_, err = indicator.Rows[technical.SimpleMovingAverageRow](table)
+	assert.Error(t, err)
+}
+
+// differences replaces each value with its change from the previous bar.
+func differences(table indicator.Table) indicator.Table {
+	return transformBars(table, func(c int, current, previous []float64) float64 {
+		return current[c] - previous[c]
+	})
+}
+
+// mamaInnovations replaces MAMA with MAMA[t]-0.99*MAMA[t-1] and FAMA with
+// FAMA[t]-0.995*FAMA[t-1]-0.005*MAMA[t].
+func mamaInnovations(table indicator.Table) indicator.Table {
+	fama, mama := slices.Index(table.Columns, "FAMA"), slices.Index(table.Columns, "MAMA")
+	return transformBars(table, func(c int, current, previous []float64) float64 {
+		if c == fama {
+			return current[fama] - 0.995*previous[fama] - 0.005*current[mama]
+		}
+		return current[mama] - 0.99*previous[mama]
+	})
+}
+
+// transformBars replaces the values of each bar using the previous bar. The
+// oldest bar is dropped.
+func transformBars(table indicator.Table, f func(column int, current, previous []float64) float64) indicator.Table {
+	result := indicator.Table{Columns: table.Columns, Values: make([][]float64, len(table.Columns))}
+	bar := func(i int) []float64 {
+		values := make([]float64, len(table.Columns))
+		for c := range values {
+			values[c] = table.Values[c][i]
+		}
+		return values
+	}
+	newestFirst := len(table.Time) > 1 && table.Time[0].After(table.Time[1])
+	for i := 1; i < len(table.Time); i++ {
+		current, previous := i, i-1
+		if newestFirst {
+			current, previous = i-1, i
+		}
+		result.Time = append(result.Time, table.Time[current])
+		for c := range table.Columns {
+			result.Values[c] = append(result.Values[c], f(c, bar(current), bar(previous)))
+		}
+	}
+	return result
+}
+
+type example struct {
+	ID   string `json:"ID"`
+	Path string `json:"path"`
+	URL  string `json:"url"`
+}
+
+func (e example) params(t *testing.T) url.Values {
+	t.Helper()
+	u, err := url.Parse(e.URL)
+	require.NoError(t, err)
+	return u.Query()
+}
+
+func technicalExamples(t *testing.T) []example {
+	t.Helper()
+	buf, err := os.ReadFile(filepath.Join(examplesDir, "index.json"))
+	require.NoError(t, err)
+	var all []example
+	require.NoError(t, json.Unmarshal(buf, &all))
+	var result []example
+	for _, e := range all {
+		if strings.HasPrefix(e.Path, "testdata/examples/technical_") {
+			e.Path = strings.TrimPrefix(e.Path, "testdata/examples/")
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+func loadSeries[T any](t *testing.T, name string, convert func([]T) indicator.Series) indicator.Series {
+	t.Helper()
+	f, err := os.Open(filepath.Join(examplesDir, filepath.FromSlash(name)))
+	require.NoError(t, err)
+	defer closeAndIgnoreError(f)
+	var rows []T
+	require.NoError(t, api.ParseCSV(f, &rows, time.UTC))
+	return convert(rows)
+}
+
+// weeklyAdjustedSeries reconstructs the adjusted weekly bars AlphaVantage
+// computes weekly indicators from. Weeks covered by the daily example are
+// aggregated from adjusted daily bars. Older weeks are scaled by the ratio of
+// the adjusted close to the close, except for the open of a week with a
+// dividend, which usually precedes the ex-dividend date and keeps the
+// previous week's factor.
+func weeklyAdjustedSeries(t *testing.T, daily indicator.Series) indicator.Series {
+	t.Helper()
+	f, err := os.Open(filepath.Join(examplesDir, "time_series/TIME_SERIES_WEEKLY_ADJUSTED_9cd0a0b1.csv"))
+	require.NoError(t, err)
+	defer closeAndIgnoreError(f)
+	var rows []timeseries.WeeklyAdjustedRow
+	require.NoError(t, api.ParseCSV(f, &rows, time.UTC))
+	slices.Reverse(rows)
+
+	weeks := make(map[[2]int][]indicator.Bar)
+	for i := range daily.Len() {
+		year, week := daily.Time[i].ISOWeek()
+		weeks[[2]int{year, week}] = append(weeks[[2]int{year, week}], daily.Bar(i))
+	}
+	firstYear, firstWeek := daily.Time[0].ISOWeek()
+	partialFirstWeek := daily.Time[0].Weekday() != time.Monday
+
+	bars := make([]indicator.Bar, len(rows))
+	for i, row := range rows {
+		year, week := row.TimeStamp.ISOWeek()
+		if days := weeks[[2]int{year, week}]; len(days) > 0 && (!partialFirstWeek || year != firstYear || week != firstWeek) {
+			bar := indicator.Bar{Time: row.TimeStamp, Open: days[0].Open, High: days[0].High, Low: days[0].Low, Close: days[len(days)-1].Close}
+			for _, day := range days {
+				bar.High = max(bar.High, day.High)
+				bar.Low = min(bar.Low, day.Low)
+				bar.Volume += day.Volume
+			}
+			bars[i] = bar
+			continue
+		}
+		factor := row.AdjustedClose / row.Close
+		openFactor := factor
+		if i > 0 && row.DividendAmount != 0 {
+			openFactor = rows[i-1].AdjustedClose / rows[i-1].Close
+		}
+		bars[i] = indicator.Bar{
+			Time:   row.TimeStamp,
+			Open:   row.Open * openFactor,
+			High:   row.High * factor,
+			Low:    row.Low * factor,
+			Close:  row.AdjustedClose,
+			Volume: float64(row.Volume),
+		}
+	}
+	return indicator.NewSeries(bars)
+}
+
+func loadTable(t *testing.T, name string) indicator.Table {
+	t.Helper()
+	f, err := os.Open(filepath.Join(examplesDir, filepath.FromSlash(name)))
+	require.NoError(t, err)
+	defer closeAndIgnoreError(f)
+	records, err := csv.NewReader(f).ReadAll()
+	require.NoError(t, err)
+	table := indicator.Table{Columns: records[0][1:], Values: make([][]float64, len(records[0])-1)}
+	for _, record := range records[1:] {
+		var tm time.Time
+		for _, layout := range []string{"2006-01-02 15:04", "2006-01-02"} {
+			if tm, err = time.Parse(layout, record[0]); err == nil {
+				break
+			}
+		}
+		require.NoError(t, err)
+		table.Time = append(table.Time, tm)
+		for c, value := range record[1:] {
+			v, err := strconv.ParseFloat(value, 64)
+			require.NoError(t, err)
+			table.Values[c] = append(table.Values[c], v)
+		}
+	}
+	return table
+}
+
+func closeAndIgnoreError(f *os.File) { _ = f.Close() }
@@ -0,0 +1,139 @@
+package indicator
+
+import "math"
+
+// MACD is the moving average convergence divergence using exponential
+// averages. It returns the MACD line, the signal line and their difference.
+func MACD(in []float64, fastPeriod, slowPeriod, signalPeriod int) (macd, signal, hist []float64) {
+	return MACDExt(in, fastPeriod, MATypeEMA, slowPeriod, MATypeEMA, signalPeriod, MATypeEMA)
+}
+
+// MACDExt is MACD with configurable moving average types.
+func MACDExt(in []float64, fastPeriod int, fastType MAType, slowPeriod int, slowType MAType, signalPeriod int, signalType MAType) (macd, signal, hist []float64) {
+	if slowPeriod < fastPeriod {
+		fastPeriod, slowPeriod = slowPeriod, fastPeriod
+		fastType, slowType = slowType, fastType
+	}
+	start := firstValid(in) + max(maLookback(fastPeriod, fastType), maLookback(slowPeriod, slowType))
+	fast := maFrom(in, start, fastPeriod, fastType)
+	slow := maFrom(in, start, slowPeriod, slowType)
+	macd = nans(len(in))
+	for i := range macd {
+		macd[i] = fast[i] - slow[i]
+	}
+	signal = MA(macd, signalPeriod, signalType)
+	trimBefore(macd, signal)
+	hist = nans(len(in))
+	for i := range hist {
+		hist[i] = macd[i] - signal[i]
+	}
+	return macd, signal, hist
+}
+
+// RSI is Wilder's relative strength index.
+func RSI(in []float64, period int) []float64 {
+	return wilderOscillator(in, period, func(gain, loss float64) float64 {
+		if isZero(gain + loss) {
+			return 0
+		}
+		return 100 * gain / (gain + loss)
+	})
+}
+
+// wilderOscillator averages gains and losses over period with Wilder
+// smoothing and combines them with f.
+func wilderOscillator(in []float64, period int, f func(gain, loss float64) float64) []float64 {
+	out := nans(len(in))
+	start := firstValid(in)
+	if period < 1 || start+period >= len(in) {
+		return out
+	}
+	gain, loss := 0.0, 0.0
+	for i := start + 1; i <= start+period; i++ {
+		if d := in[i] - in[i-1]; d < 0 {
+			loss -= d
+		} else {
+			gain += d
+		}
+	}
+	p := float64(period)
+	gain /= p
+	loss /= p
+	out[start+period] = f(gain, loss)
+	for i := start + period + 1; i < len(in); i++ {
+		gain *= p - 1
+		loss *= p - 1
+		if d := in[i] - in[i-1]; d < 0 {
+			loss -= d
+		} else {
+			gain += d
+		}
+		gain /= p
+		loss /= p
+		out[i] = f(gain, loss)
+	}
+	return out
+}
+
+// StochF is the fast stochastic oscillator.
+func StochF(high, low, close []float64, fastKPeriod, fastDPeriod int, fastDType MAType) (fastK, fastD []float64) {
+	fastK = stochK(high, low, close, fastKPeriod)
+	fastD = MA(fastK, fastDPeriod, fastDType)
+	trimBefore(fastK, fastD)
+	return fastK, fastD
+}
+
+// Stoch is the slow stochastic oscillator.
+func Stoch(high, low, close []float64, fastKPeriod, slowKPeriod int, slowKType MAType, slowDPeriod int, slowDType MAType) (slowK, slowD []float64) {
+	slowK = MA(stochK(high, low, close, fastKPeriod), slowKPeriod, slowKType)
+	slowD = MA(slowK, slowDPeriod, slowDType)
+	trimBefore(slowK, slowD)
+	return slowK, slowD
+}
+
+// StochRSI is the fast stochastic oscillator applied to RSI.
+func StochRSI(in []float64, period, fastKPeriod, fastDPeriod int, fastDType MAType) (fastK, fastD []float64) {
+	rsi := RSI(in, period)
+	return StochF(rsi, rsi, rsi, fastKPeriod, fastDPeriod, fastDType)
+}
+
+func stochK(high, low, close []float64, period int) []float64 {
+	out := nans(len(close))
+	start := max(firstValid(high), firstValid(low), firstValid(close))
+	for i := start + period - 1; i < len(close); i++ {
+		highest, lowest := windowMax(high, i, period), windowMin(low, i, period)
+		if diff := highest - lowest; diff != 0 {
+			out[i] = 100 * (close[i] - lowest) / diff
+		} else {
+			out[i] = 0
+		}
+	}
+	return out
+}
+
+// trimBefore sets values to NaN where the last series is still in its
+// lookback region so multi-output indicators start on the same bar.
+func trimBefore(values ...[]float64) {
+	last := values[len(values)-1]
+	for i := range firstValid(last) {
+		for _, v := range values {
+			v[i] = math.NaN()
+		}
+	}
+}
+
+func windowMax(in []float64, end, period int) float64 {
+	out := in[end]
+	for i := end - period + 1; i < end; i++ {
+		out = max(out, in[i])
+	}
+	return out
+}
+
+func windowMin(in []float64, end, period int) float64 {
+	out := in[end]
+	for i := end - period + 1; i < end; i++ {
+		out = min(out, in[i])
+	}
+	return out
+}
@@ -0,0 +1,131 @@
+package indicator
+
+import "math"
+
+// WillR is Williams' %R.
+func WillR(high, low, close []float64, period int) []float64 {
+	out := nans(len(close))
+	start := max(firstValid(high), firstValid(low), firstValid(close))
+	for i := start + period - 1; i < len(close); i++ {
+		highest, lowest := windowMax(high, i, period), windowMin(low, i, period)
+		if diff := highest - lowest; diff != 0 {
+			out[i] = -100 * (highest - close[i]) / diff
+		} else {
+			out[i] = 0
+		}
+	}
+	return out
+}
+
+// APO is the absolute price oscillator: the fast average minus the slow one.
+func APO(in []float64, fastPeriod, slowPeriod int, maType MAType) []float64 {
+	fast, slow := priceOscillator(in, fastPeriod, slowPeriod, maType)
+	out := nans(len(in))
+	for i := range out {
+		out[i] = fast[i] - slow[i]
+	}
+	return out
+}
+
+// PPO is the percentage price oscillator.
+func PPO(in []float64, fastPeriod, slowPeriod int, maType MAType) []float64 {
+	fast, slow := priceOscillator(in, fastPeriod, slowPeriod, maType)
+	out := nans(len(in))
+	for i := range out {
+		switch {
+		case math.IsNaN(slow[i]):
+		case isZero(slow[i]):
+			out[i] = 0
+		default:
+			out[i] = 100 * (fast[i] - slow[i]) / slow[i]
+		}
+	}
+	return out
+}
+
+func priceOscillator(in []float64, fastPeriod, slowPeriod int, maType MAType) (fast, slow []float64) {
+	if slowPeriod < fastPeriod {
+		fastPeriod, slowPeriod = slowPeriod, fastPeriod
+	}
+	start := firstValid(in) + maLookback(slowPeriod, maType)
+	return maFrom(in, start, fastPeriod, maType), maFrom(in, start, slowPeriod, maType)
+}
+
+// Mom is the momentum: the change over period bars.
+func Mom(in []float64, period int) []float64 {
+	return lagged(in, period, func(v, prev float64) float64 { return v - prev })
+}
+
+// ROC is the rate of change in percent.
+func ROC(in []float64, period int) []float64 {
+	return lagged(in, period, func(v, prev float64) float64 {
+		if prev == 0 {
+			return 0
+		}
+		return (v/prev - 1) * 100
+	})
+}
+
+// ROCR is the rate of change ratio.
+func ROCR(in []float64, period int) []float64 {
+	return lagged(in, period, func(v, prev float64) float64 {
+		if prev == 0 {
+			return 0
+		}
+		return v / prev
+	})
+}
+
+func lagged(in []float64, period int, f func(v, prev float64) float64) []float64 {
+	out := nans(len(in))
+	for i := firstValid(in) + period; i < len(in); i++ {
+		out[i] = f(in[i], in[i-period])
+	}
+	return out
+}
+
+// BOP is the balance of power.
+func BOP(open, high, low, close []float64) []float64 {
+	out := nans(len(close))
+	for i := range out {
+		if r := high[i] - low[i]; r > 0 {
+			out[i] = (close[i] - open[i]) / r
+		} else {
+			out[i] = 0
+		}
+	}
+	return out
+}
+
+// CCI is the commodity channel index.
+func CCI(high, low, close []float64, period int) []float64 {
+	out := nans(len(close))
+	typical := make([]float64, len(close))
+	for i := range typical {
+		typical[i] = (high[i] + low[i] + close[i]) / 3
+	}
+	average := SMA(typical, period)
+	for i := firstValid(average); i < len(close); i++ {
+		deviation := 0.0
+		for j := i - period + 1; j <= i; j++ {
+			deviation += math.Abs(typical[j] - average[i])
+		}
+		diff := typical[i] - average[i]
+		if diff != 0 && deviation != 0 {
+			out[i] = diff / (0.015 * deviation / float64(period))
+		} else {
+			out[i] = 0
+		}
+	}
+	return out
+}
+
+// CMO is the Chande momentum oscillator.
+func CMO(in []float64, period int) []float64 {
+	return wilderOscillator(in, period, func(gain, loss float64) float64 {
+		if isZero(gain + loss) {
+			return 0
+		}
+		return 100 * (gain - loss) / (gain + loss)
+	})
+}
@@ -0,0 +1,260 @@
+package indicator
+
+import (
+	"fmt"
+	"math"
+)
+
+// MAType selects a moving average. The values match the AlphaVantage matype
+// parameters (fastmatype, slowkmatype, …).
+type MAType int
+
+const (
+	MATypeSMA MAType = iota
+	MATypeEMA
+	MATypeWMA
+	MATypeDEMA
+	MATypeTEMA
+	MATypeTRIMA
+	MATypeT3
+	MATypeKAMA
+	MATypeMAMA
+)
+
+func (t MAType) String() string {
+	switch t {
+	case MATypeSMA:
+		return "SMA"
+	case MATypeEMA:
+		return "EMA"
+	case MATypeWMA:
+		return "WMA"
+	case MATypeDEMA:
+		return "DEMA"
+	case MATypeTEMA:
+		return "TEMA"
+	case MATypeTRIMA:
+		return "TRIMA"
+	case MATypeT3:
+		return "T3"
+	case MATypeKAMA:
+		return "KAMA"
+	case MATypeMAMA:
+		return "MAMA"
+	}
+	return fmt.Sprintf("MAType(%d)", int(t))
+}
+
+// MA computes the moving average selected by maType. MAMA ignores period and
+// uses the AlphaVantage default limits.
+func MA(in []float64, period int, maType MAType) []float64 {
+	switch maType {
+	case MATypeEMA:
+		return EMA(in, period)
+	case MATypeWMA:
+		return WMA(in, period)
+	case MATypeDEMA:
+		return DEMA(in, period)
+	case MATypeTEMA:
+		return TEMA(in, period)
+	case MATypeTRIMA:
+		return TRIMA(in, period)
+	case MATypeT3:
+		return T3(in, period, defaultT3VFactor)
+	case MATypeKAMA:
+		return KAMA(in, period)
+	case MATypeMAMA:
+		mama, _ := MAMA(in, defaultMAMALimit, defaultMAMALimit)
+		return mama
+	}
+	return SMA(in, period)
+}
+
+// maLookback is the number of leading NaN values MA produces for an input
+// without gaps.
+func maLookback(period int, maType MAType) int {
+	if period <= 1 && maType != MATypeMAMA {
+		return 0
+	}
+	switch maType {
+	case MATypeDEMA:
+		return 2 * (period - 1)
+	case MATypeTEMA:
+		return 3 * (period - 1)
+	case MATypeT3:
+		return 6 * (period - 1)
+	case MATypeKAMA:
+		return period
+	case MATypeMAMA:
+		return hilbertLookback
+	}
+	return period - 1
+}
+
+// maFrom computes MA so the first value lands on index start, using only the
+// input needed for that value's lookback. TA-Lib computes the inner averages
+// of MACD, APO, PPO and the stochastics this way, which matters for the
+// recursive averages.
+func maFrom(in []float64, start, period int, maType MAType) []float64 {
+	out := nans(len(in))
+	from := start - maLookback(period, maType)
+	if from < 0 || start >= len(in) {
+		return out
+	}
+	copy(out[from:], MA(in[from:], period, maType))
+	return out
+}
+
+// SMA is the simple moving average.
+func SMA(in []float64, period int) []float64 {
+	out := nans(len(in))
+	start := firstValid(in)
+	if period < 1 || start+period > len(in) {
+		return out
+	}
+	sum := 0.0
+	for i := start; i < len(in); i++ {
+		sum += in[i]
+		if i >= start+period {
+			sum -= in[i-period]
+		}
+		if i >= start+period-1 {
+			out[i] = sum / float64(period)
+		}
+	}
+	return out
+}
+
+// EMA is the exponential moving average with smoothing 2/(period+1) seeded
+// with the simple average of the first period values.
+func EMA(in []float64, period int) []float64 {
+	return ema(in, period, 2/float64(period+1))
+}
+
+func ema(in []float64, period int, k float64) []float64 {
+	out := nans(len(in))
+	start := firstValid(in)
+	if period < 1 || start+period > len(in) {
+		return out
+	}
+	prev := 0.0
+	for i := start; i < start+period; i++ {
+		prev += in[i]
+	}
+	prev /= float64(period)
+	out[start+period-1] = prev
+	for i := start + period; i < len(in); i++ {
+		prev = (in[i]-prev)*k + prev
+		out[i] = prev
+	}
+	return out
+}
+
+// WMA is the linearly weighted moving average.
+func WMA(in []float64, period int) []float64 {
+	out := nans(len(in))
+	start := firstValid(in)
+	if period < 1 || start+period > len(in) {
+		return out
+	}
+	divider := float64(period*(period+1)) / 2
+	for i := start + period - 1; i < len(in); i++ {
+		sum := 0.0
+		for j := range period {
+			sum += in[i-j] * float64(period-j)
+		}
+		out[i] = sum / divider
+	}
+	return out
+}
+
+// DEMA is the double exponential moving average.
+func DEMA(in []float64, period int) []float64 {
+	e1 := EMA(in, period)
+	e2 := EMA(e1, period)
+	out := nans(len(in))
+	for i := range out {
+		out[i] = 2*e1[i] - e2[i]
+	}
+	return out
+}
+
+// TEMA is the triple exponential moving average.
+func TEMA(in []float64, period int) []float64 {
+	e1 := EMA(in, period)
+	e2 := EMA(e1, period)
+	e3 := EMA(e2, period)
+	out := nans(len(in))
+	for i := range out {
+		out[i] = 3*e1[i] - 3*e2[i] + e3[i]
+	}
+	return out
+}
+
+// TRIMA is the triangular moving average.
+func TRIMA(in []float64, period int) []float64 {
+	if period%2 == 1 {
+		n := (period + 1) / 2
+		return SMA(SMA(in, n), n)
+	}
+	return SMA(SMA(in, period/2+1), period/2)
+}
+
+// KAMA is Kaufman's adaptive moving average.
+func KAMA(in []float64, period int) []float64 {
+	const (
+		slowest = 2.0 / (30 + 1)
+		diff    = 2.0/(2+1) - slowest
+	)
+	out := nans(len(in))
+	start := firstValid(in)
+	if period < 1 || start+period >= len(in) {
+		return out
+	}
+	volatility := 0.0
+	for i := start + 1; i <= start+period; i++ {
+		volatility += math.Abs(in[i] - in[i-1])
+	}
+	prev := in[start+period-1]
+	for i := start + period; i < len(in); i++ {
+		if i > start+period {
+			volatility += math.Abs(in[i]-in[i-1]) - math.Abs(in[i-period]-in[i-period-1])
+		}
+		change := in[i] - in[i-period]
+		efficiency := 1.0
+		if volatility > change && !isZero(volatility) {
+			efficiency = math.Abs(change / volatility)
+		}
+		sc := efficiency*diff + slowest
+		prev += (in[i] - prev) * sc * sc
+		out[i] = prev
+	}
+	return out
+}
+
+const defaultT3VFactor = 0.7
+
+// T3 is Tillson's T3 moving average with volume factor vFactor.
+func T3(in []float64, period int, vFactor float64) []float64 {
+	e1 := EMA(in, period)
+	e2 := EMA(e1, period)
+	e3 := EMA(e2, period)
+	e4 := EMA(e3, period)
+	e5 := EMA(e4, period)
+	e6 := EMA(e5, period)
+	a := vFactor
+	c1 := -a * a * a
+	c2 := 3*a*a + 3*a*a*a
+	c3 := -6*a*a - 3*a - 3*a*a*a
+	c4 := 1 + 3*a + a*a*a + 3*a*a
+	out := nans(len(in))
+	for i := range out {
+		out[i] = c1*e6[i] + c2*e5[i] + c3*e4[i] + c4*e3[i]
+	}
+	return out
+}
+
+// isZero matches the TA-Lib tolerance for comparisons with zero.
+func isZero(v float64) bool {
+	return -0.00000001 < v && v < 0.00000001
+}
@@ -0,0 +1,20 @@
+package indicator
+
+// MidPoint is the average of the highest and lowest value over period bars.
+func MidPoint(in []float64, period int) []float64 {
+	out := nans(len(in))
+	for i := firstValid(in) + period - 1; i < len(in); i++ {
+		out[i] = (windowMax(in, i, period) + windowMin(in, i, period)) / 2
+	}
+	return out
+}
+
+// MidPrice is the average of the highest high and the lowest low over period
+// bars.
+func MidPrice(high, low []float64, period int) []float64 {
+	out := nans(len(high))
+	for i := period - 1; i < len(high); i++ {
+		out[i] = (windowMax(high, i, period) + windowMin(low, i, period)) / 2
+	}
+	return out
+}
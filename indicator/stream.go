@@ -0,0 +1,389 @@
+package indicator
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+
+	"github.com/portfoliotree/alphavantage/query/technical"
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+)
+
+// Stream is an indicator updated one bar at a time. Bars must be passed in
+// chronological order. Update returns the value for the latest bar, which is
+// NaN until the indicator has seen its lookback; after that it matches the
+// value the batch function would compute for the same bars.
+//
+// The streaming constructors read their parameters from the technical query
+// builders, so a dashboard can use the query it would send to AlphaVantage:
+//
+//	q := technical.QueryRelativeStrengthIndex(apiKey, "IBM", "5min", "close").TimePeriod("10")
+//	rsi, err := indicator.NewRSIStream(q)
+//	last := indicator.Seed(rsi, history)
+//	next := rsi.Update(bar)
+type Stream[V any] interface {
+	Update(bar Bar) V
+}
+
+// Seed updates s with the intraday rows in chronological order and returns
+// the value for the most recent row. The rows may be in the newest first
+// order AlphaVantage returns them in.
+func Seed[V any](s Stream[V], rows []timeseries.IntradayRow) V {
+	var value V
+	series := FromIntradayRows(rows)
+	for i := range series.Len() {
+		value = s.Update(series.Bar(i))
+	}
+	return value
+}
+
+// EMAStream is the streaming counterpart of EMA.
+type EMAStream struct {
+	field func(Bar) float64
+	ema   emaState
+}
+
+// NewEMAStream reads time_period and series_type from q.
+func NewEMAStream(q technical.ExponentialMovingAverageQuery) (*EMAStream, error) {
+	p := parameters{values: url.Values(q)}
+	period := p.int("time_period", 30)
+	field, err := barField(url.Values(q).Get("series_type"))
+	if err != nil {
+		p.errs = append(p.errs, err)
+	}
+	if err := p.err(); err != nil {
+		return nil, fmt.Errorf("EMA: %w", err)
+	}
+	return &EMAStream{field: field, ema: newEMAState(period)}, nil
+}
+
+func (s *EMAStream) Update(bar Bar) float64 { return s.ema.update(s.field(bar)) }
+
+// RSIStream is the streaming counterpart of RSI.
+type RSIStream struct {
+	field      func(Bar) float64
+	period     float64
+	n          int
+	prev       float64
+	gain, loss float64
+}
+
+// NewRSIStream reads time_period and series_type from q.
+func NewRSIStream(q technical.RelativeStrengthIndexQuery) (*RSIStream, error) {
+	p := parameters{values: url.Values(q)}
+	period := p.int("time_period", 14)
+	field, err := barField(url.Values(q).Get("series_type"))
+	if err != nil {
+		p.errs = append(p.errs, err)
+	}
+	if err := p.err(); err != nil {
+		return nil, fmt.Errorf("RSI: %w", err)
+	}
+	return &RSIStream{field: field, period: float64(period)}, nil
+}
+
+func (s *RSIStream) Update(bar Bar) float64 {
+	price := s.field(bar)
+	s.n++
+	if s.n == 1 {
+		s.prev = price
+		return math.NaN()
+	}
+	d := price - s.prev
+	s.prev = price
+	warm := s.n-1 > int(s.period)
+	if warm {
+		s.gain *= s.period - 1
+		s.loss *= s.period - 1
+	}
+	if d < 0 {
+		s.loss -= d
+	} else {
+		s.gain += d
+	}
+	if !warm && s.n-1 < int(s.period) {
+		return math.NaN()
+	}
+	s.gain /= s.period
+	s.loss /= s.period
+	if isZero(s.gain + s.loss) {
+		return 0
+	}
+	return 100 * s.gain / (s.gain + s.loss)
+}
+
+// MACDValue is one bar of MACD output.
+type MACDValue struct {
+	MACD, Signal, Hist float64
+}
+
+// MACDStream is the streaming counterpart of MACD.
+type MACDStream struct {
+	field             func(Bar) float64
+	n, fastFrom, slow int
+	fast, slowEMA     emaState
+	signal            emaState
+}
+
+// NewMACDStream reads fastperiod, slowperiod, signalperiod and series_type
+// from q.
+func NewMACDStream(q technical.MovingAverageConvergenceDivergenceQuery) (*MACDStream, error) {
+	p := parameters{values: url.Values(q)}
+	fast, slow, signal := p.int("fastperiod", 12), p.int("slowperiod", 26), p.int("signalperiod", 9)
+	field, err := barField(url.Values(q).Get("series_type"))
+	if err != nil {
+		p.errs = append(p.errs, err)
+	}
+	if err := p.err(); err != nil {
+		return nil, fmt.Errorf("MACD: %w", err)
+	}
+	if slow < fast {
+		fast, slow = slow, fast
+	}
+	return &MACDStream{
+		field:    field,
+		fastFrom: slow - fast,
+		slow:     slow,
+		fast:     newEMAState(fast),
+		slowEMA:  newEMAState(slow),
+		signal:   newEMAState(signal),
+	}, nil
+}
+
+func (s *MACDStream) Update(bar Bar) MACDValue {
+	price := s.field(bar)
+	nan := math.NaN()
+	// like MACD, the fast average starts so that both averages are seeded on
+	// the same bar
+	fast := nan
+	if s.n >= s.fastFrom {
+		fast = s.fast.update(price)
+	}
+	slow := s.slowEMA.update(price)
+	s.n++
+	if s.n < s.slow {
+		return MACDValue{MACD: nan, Signal: nan, Hist: nan}
+	}
+	macd := fast - slow
+	signal := s.signal.update(macd)
+	if math.IsNaN(signal) {
+		return MACDValue{MACD: nan, Signal: nan, Hist: nan}
+	}
+	return MACDValue{MACD: macd, Signal: signal, Hist: macd - signal}
+}
+
+// BBandsValue is one bar of Bollinger Bands output.
+type BBandsValue struct {
+	Lower, Middle, Upper float64
+}
+
+// BBandsStream is the streaming counterpart of BBands. It supports the
+// simple (matype 0) and exponential (matype 1) middle bands.
+type BBandsStream struct {
+	field            func(Bar) float64
+	nbDevUp, nbDevDn float64
+	maType           MAType
+	ema              emaState
+
+	window          []float64
+	n               int
+	sum, sumSquares float64
+}
+
+// NewBBandsStream reads time_period, nbdevup, nbdevdn, matype and
+// series_type from q.
+func NewBBandsStream(q technical.BollingerBandsQuery) (*BBandsStream, error) {
+	p := parameters{values: url.Values(q)}
+	period := p.int("time_period", 5)
+	nbDevUp, nbDevDn := p.float("nbdevup", 2), p.float("nbdevdn", 2)
+	maType := p.maType("matype")
+	if maType != MATypeSMA && maType != MATypeEMA {
+		p.errs = append(p.errs, fmt.Errorf("matype %s is not supported when streaming", maType))
+	}
+	field, err := barField(url.Values(q).Get("series_type"))
+	if err != nil {
+		p.errs = append(p.errs, err)
+	}
+	if err := p.err(); err != nil {
+		return nil, fmt.Errorf("BBANDS: %w", err)
+	}
+	return &BBandsStream{
+		field:   field,
+		nbDevUp: nbDevUp,
+		nbDevDn: nbDevDn,
+		maType:  maType,
+		ema:     newEMAState(period),
+		window:  make([]float64, period),
+	}, nil
+}
+
+func (s *BBandsStream) Update(bar Bar) BBandsValue {
+	price := s.field(bar)
+	period := len(s.window)
+	i := s.n % period
+	s.sum += price
+	s.sumSquares += price * price
+	if s.n >= period {
+		s.sum -= s.window[i]
+		s.sumSquares -= s.window[i] * s.window[i]
+	}
+	s.window[i] = price
+	s.n++
+	middle := s.sum / float64(period)
+	if s.maType == MATypeEMA {
+		middle = s.ema.update(price)
+	}
+	if s.n < period {
+		nan := math.NaN()
+		return BBandsValue{Lower: nan, Middle: nan, Upper: nan}
+	}
+	p := float64(period)
+	mean := s.sum / p
+	deviation := 0.0
+	if variance := s.sumSquares/p - mean*mean; variance > 0 && !isZero(variance) {
+		deviation = math.Sqrt(variance)
+	}
+	return BBandsValue{
+		Lower:  middle - s.nbDevDn*deviation,
+		Middle: middle,
+		Upper:  middle + s.nbDevUp*deviation,
+	}
+}
+
+// ATRStream is the streaming counterpart of ATR.
+type ATRStream struct {
+	period    int
+	n         int
+	prevClose float64
+	atr       float64
+}
+
+// NewATRStream reads time_period from q.
+func NewATRStream(q technical.AverageTrueRangeQuery) (*ATRStream, error) {
+	p := parameters{values: url.Values(q)}
+	period := p.int("time_period", 14)
+	if err := p.err(); err != nil {
+		return nil, fmt.Errorf("ATR: %w", err)
+	}
+	return &ATRStream{period: period}, nil
+}
+
+func (s *ATRStream) Update(bar Bar) float64 {
+	s.n++
+	prevClose := s.prevClose
+	s.prevClose = bar.Close
+	if s.n == 1 {
+		return math.NaN()
+	}
+	tr := max(bar.High, prevClose) - min(bar.Low, prevClose)
+	if s.period <= 1 {
+		return tr
+	}
+	p := float64(s.period)
+	switch bars := s.n - 1; {
+	case bars < s.period:
+		s.atr += tr
+		return math.NaN()
+	case bars == s.period:
+		s.atr = (s.atr + tr) / p
+	default:
+		s.atr = (s.atr*(p-1) + tr) / p
+	}
+	return s.atr
+}
+
+// OBVStream is the streaming counterpart of OBV.
+type OBVStream struct {
+	started   bool
+	prevClose float64
+	obv       float64
+}
+
+// NewOBVStream returns an on balance volume starting at the volume of the
+// first bar.
+func NewOBVStream() *OBVStream { return new(OBVStream) }
+
+func (s *OBVStream) Update(bar Bar) float64 {
+	switch {
+	case !s.started:
+		s.started = true
+		s.obv = bar.Volume
+	case bar.Close > s.prevClose:
+		s.obv += bar.Volume
+	case bar.Close < s.prevClose:
+		s.obv -= bar.Volume
+	}
+	s.prevClose = bar.Close
+	return s.obv
+}
+
+// VWAPStream is the streaming counterpart of VWAP. It restarts on each
+// calendar day of the bar times.
+type VWAPStream struct {
+	last          Bar
+	started       bool
+	value, volume float64
+	vwap          float64
+}
+
+// NewVWAPStream returns a volume weighted average price.
+func NewVWAPStream() *VWAPStream { return &VWAPStream{vwap: math.NaN()} }
+
+func (s *VWAPStream) Update(bar Bar) float64 {
+	if s.started {
+		if y, m, d := bar.Time.Date(); y != s.last.Time.Year() || m != s.last.Time.Month() || d != s.last.Time.Day() {
+			s.value, s.volume = 0, 0
+		}
+	}
+	s.started = true
+	s.last = bar
+	s.value += (bar.High + bar.Low + bar.Close) / 3 * bar.Volume
+	s.volume += bar.Volume
+	if s.volume > 0 {
+		s.vwap = s.value / s.volume
+	}
+	return s.vwap
+}
+
+// emaState is an EMA seeded with the simple average of the first period
+// values, updated one value at a time.
+type emaState struct {
+	period int
+	k      float64
+	n      int
+	value  float64
+}
+
+func newEMAState(period int) emaState {
+	return emaState{period: period, k: 2 / float64(period+1)}
+}
+
+func (e *emaState) update(in float64) float64 {
+	e.n++
+	switch {
+	case e.n < e.period:
+		e.value += in
+		return math.NaN()
+	case e.n == e.period:
+		e.value = (e.value + in) / float64(e.period)
+	default:
+		e.value = (in-e.value)*e.k + e.value
+	}
+	return e.value
+}
+
+// barField returns the bar price selected by an AlphaVantage series_type
+// value.
+func barField(seriesType string) (func(Bar) float64, error) {
+	switch seriesType {
+	case "open":
+		return func(b Bar) float64 { return b.Open }, nil
+	case "high":
+		return func(b Bar) float64 { return b.High }, nil
+	case "low":
+		return func(b Bar) float64 { return b.Low }, nil
+	case "close":
+		return func(b Bar) float64 { return b.Close }, nil
+	}
+	return nil, fmt.Errorf("unknown series_type %q", seriesType)
+}
@@ -0,0 +1,131 @@
+package indicator_test
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/portfoliotree/alphavantage/api"
+	"github.com/portfoliotree/alphavantage/indicator"
+	"github.com/portfoliotree/alphavantage/query/technical"
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+)
+
+func TestStream(t *testing.T) {
+	rows := intradayRows(t)
+	series := indicator.FromIntradayRows(rows)
+
+	t.Run("EMA", func(t *testing.T) {
+		s, err := indicator.NewEMAStream(technical.QueryExponentialMovingAverage("demo", "IBM", "5min", "high").TimePeriod("10"))
+		require.NoError(t, err)
+		assertStream(t, series, s, indicator.EMA(series.High, 10))
+	})
+	t.Run("RSI", func(t *testing.T) {
+		s, err := indicator.NewRSIStream(technical.QueryRelativeStrengthIndex("demo", "IBM", "5min", "close"))
+		require.NoError(t, err)
+		assertStream(t, series, s, indicator.RSI(series.Close, 14))
+	})
+	t.Run("MACD", func(t *testing.T) {
+		q := technical.QueryMovingAverageConvergenceDivergence("demo", "IBM", "5min", "close").FastPeriod("20").SlowPeriod("8").SignalPeriod("5")
+		macd, signal, hist := indicator.MACD(series.Close, 20, 8, 5)
+		for want, value := range map[*[]float64]func(indicator.MACDValue) float64{
+			&macd:   func(v indicator.MACDValue) float64 { return v.MACD },
+			&signal: func(v indicator.MACDValue) float64 { return v.Signal },
+			&hist:   func(v indicator.MACDValue) float64 { return v.Hist },
+		} {
+			s, err := indicator.NewMACDStream(q)
+			require.NoError(t, err)
+			assertStream(t, series, stream(s, value), *want)
+		}
+	})
+	t.Run("BBANDS", func(t *testing.T) {
+		for _, maType := range []indicator.MAType{indicator.MATypeSMA, indicator.MATypeEMA} {
+			q := technical.QueryBollingerBands("demo", "IBM", "5min", "close").TimePeriod("20").UpperBandStandardDeviationMultiplier("3").MovingAverageType(strconv.Itoa(int(maType)))
+			lower, middle, upper := indicator.BBands(series.Close, 20, 3, 2, maType)
+			for column, want := range map[string][]float64{"lower": lower, "middle": middle, "upper": upper} {
+				s, err := indicator.NewBBandsStream(q)
+				require.NoError(t, err)
+				assertStream(t, series, stream(s, func(v indicator.BBandsValue) float64 {
+					return map[string]float64{"lower": v.Lower, "middle": v.Middle, "upper": v.Upper}[column]
+				}), want)
+			}
+		}
+		_, err := indicator.NewBBandsStream(technical.QueryBollingerBands("demo", "IBM", "5min", "close").MovingAverageType("2"))
+		assert.Error(t, err)
+	})
+	t.Run("ATR", func(t *testing.T) {
+		s, err := indicator.NewATRStream(technical.QueryAverageTrueRange("demo", "IBM", "5min").TimePeriod("7"))
+		require.NoError(t, err)
+		assertStream(t, series, s, indicator.ATR(series.High, series.Low, series.Close, 7))
+	})
+	t.Run("OBV", func(t *testing.T) {
+		assertStream(t, series, indicator.NewOBVStream(), indicator.OBV(series.Close, series.Volume))
+	})
+	t.Run("VWAP", func(t *testing.T) {
+		assertStream(t, series, indicator.NewVWAPStream(), indicator.VWAP(series))
+	})
+}
+
+func TestSeed(t *testing.T) {
+	rows := intradayRows(t)
+	series := indicator.FromIntradayRows(rows)
+	s, err := indicator.NewRSIStream(technical.QueryRelativeStrengthIndex("demo", "IBM", "5min", "close"))
+	require.NoError(t, err)
+
+	// rows are newest first; seed with everything but the latest bar
+	last := indicator.Seed(s, rows[1:])
+	want := indicator.RSI(series.Close, 14)
+	assert.InDelta(t, want[len(want)-2], last, 1e-9)
+	assert.InDelta(t, want[len(want)-1], s.Update(series.Bar(series.Len()-1)), 1e-9)
+
+	_, err = indicator.NewEMAStream(technical.QueryExponentialMovingAverage("demo", "IBM", "5min", "volume"))
+	assert.Error(t, err)
+}
+
+func TestVWAPStream(t *testing.T) {
+	day := time.Date(2026, 5, 14, 9, 30, 0, 0, time.UTC)
+	s := indicator.NewVWAPStream()
+	assert.Equal(t, 10.0, s.Update(indicator.Bar{Time: day, High: 11, Low: 9, Close: 10, Volume: 100}))
+	assert.Equal(t, 11.5, s.Update(indicator.Bar{Time: day.Add(15 * time.Minute), High: 13, Low: 11, Close: 12, Volume: 300}))
+	assert.Equal(t, 20.0, s.Update(indicator.Bar{Time: day.AddDate(0, 0, 1), High: 21, Low: 19, Close: 20, Volume: 50}))
+}
+
+type streamFunc func(indicator.Bar) float64
+
+func (f streamFunc) Update(bar indicator.Bar) float64 { return f(bar) }
+
+func stream[V any](s indicator.Stream[V], value func(V) float64) indicator.Stream[float64] {
+	return streamFunc(func(bar indicator.Bar) float64 { return value(s.Update(bar)) })
+}
+
+// assertStream checks that updating s bar by bar reproduces the batch values.
+func assertStream(t *testing.T, series indicator.Series, s indicator.Stream[float64], want []float64) {
+	t.Helper()
+	valid := 0
+	for i := range series.Len() {
+		got := s.Update(series.Bar(i))
+		if math.IsNaN(want[i]) {
+			assert.True(t, math.IsNaN(got), "bar %d: got %v, want NaN", i, got)
+			continue
+		}
+		valid++
+		assert.InDelta(t, want[i], got, 1e-9, "bar %d", i)
+	}
+	assert.NotZero(t, valid)
+}
+
+func intradayRows(t *testing.T) []timeseries.IntradayRow {
+	t.Helper()
+	f, err := os.Open(filepath.Join(examplesDir, "time_series/TIME_SERIES_INTRADAY_3dbdcdc7.csv"))
+	require.NoError(t, err)
+	defer closeAndIgnoreError(f)
+	var rows []timeseries.IntradayRow
+	require.NoError(t, api.ParseCSV(f, &rows, time.UTC))
+	return rows
+}
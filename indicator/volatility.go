@@ -0,0 +1,195 @@
+package indicator
+
+import (
+	"math"
+	"slices"
+)
+
+// TRange is the true range. The first value is NaN.
+func TRange(high, low, close []float64) []float64 {
+	return trueRange(high, low, close)
+}
+
+func trueRange(high, low, close []float64) []float64 {
+	out := nans(len(close))
+	for i := 1; i < len(close); i++ {
+		out[i] = max(high[i], close[i-1]) - min(low[i], close[i-1])
+	}
+	return out
+}
+
+// ATR is Wilder's average true range.
+func ATR(high, low, close []float64, period int) []float64 {
+	tr := trueRange(high, low, close)
+	out := nans(len(close))
+	if period <= 1 {
+		return tr
+	}
+	if period >= len(close) {
+		return out
+	}
+	p := float64(period)
+	prev := 0.0
+	for i := 1; i <= period; i++ {
+		prev += tr[i]
+	}
+	prev /= p
+	out[period] = prev
+	for i := period + 1; i < len(close); i++ {
+		prev = (prev*(p-1) + tr[i]) / p
+		out[i] = prev
+	}
+	return out
+}
+
+// NATR is the average true range as a percentage of the close.
+func NATR(high, low, close []float64, period int) []float64 {
+	out := ATR(high, low, close, period)
+	for i, v := range out {
+		if math.IsNaN(v) {
+			continue
+		}
+		if close[i] != 0 {
+			out[i] = 100 * v / close[i]
+		} else {
+			out[i] = 0
+		}
+	}
+	return out
+}
+
+// BBands returns the lower, middle and upper Bollinger Bands. The bands are
+// nbDevDn and nbDevUp population standard deviations from the middle band.
+func BBands(in []float64, period int, nbDevUp, nbDevDn float64, maType MAType) (lower, middle, upper []float64) {
+	middle = MA(in, period, maType)
+	deviation := StdDev(in, period)
+	lower, upper = nans(len(in)), nans(len(in))
+	for i := range in {
+		if math.IsNaN(middle[i]) || math.IsNaN(deviation[i]) {
+			middle[i] = math.NaN()
+			continue
+		}
+		lower[i] = middle[i] - nbDevDn*deviation[i]
+		upper[i] = middle[i] + nbDevUp*deviation[i]
+	}
+	return lower, middle, upper
+}
+
+// StdDev is the population standard deviation over period values.
+func StdDev(in []float64, period int) []float64 {
+	out := nans(len(in))
+	start := firstValid(in)
+	if period < 1 || start+period > len(in) {
+		return out
+	}
+	sum, sumSquares := 0.0, 0.0
+	p := float64(period)
+	for i := start; i < len(in); i++ {
+		sum += in[i]
+		sumSquares += in[i] * in[i]
+		if i >= start+period {
+			sum -= in[i-period]
+			sumSquares -= in[i-period] * in[i-period]
+		}
+		if i < start+period-1 {
+			continue
+		}
+		mean := sum / p
+		if variance := sumSquares/p - mean*mean; variance > 0 && !isZero(variance) {
+			out[i] = math.Sqrt(variance)
+		} else {
+			out[i] = 0
+		}
+	}
+	return out
+}
+
+// SAR is Wilder's parabolic stop and reverse. The initial direction is
+// short when the second bar has a minus directional movement.
+func SAR(high, low []float64, acceleration, maximum float64) []float64 {
+	out := nans(len(high))
+	if len(high) < 2 {
+		return out
+	}
+	if acceleration > maximum {
+		acceleration = maximum
+	}
+	af := acceleration
+	_, minus := directionalMovement(high[:2], low[:2])
+	long := !(minus[1] > 0)
+
+	var ep, sar float64
+	if long {
+		ep, sar = high[1], low[0]
+	} else {
+		ep, sar = low[1], high[0]
+	}
+	newHigh, newLow := high[1], low[1]
+	for i := 1; i < len(high); i++ {
+		prevHigh, prevLow := newHigh, newLow
+		newHigh, newLow = high[i], low[i]
+		if long {
+			if newLow <= sar {
+				long = false
+				sar = max(ep, prevHigh, newHigh)
+				out[i] = sar
+				af = acceleration
+				ep = newLow
+				sar = max(sar+af*(ep-sar), prevHigh, newHigh)
+				continue
+			}
+			out[i] = sar
+			if newHigh > ep {
+				ep = newHigh
+				af = min(af+acceleration, maximum)
+			}
+			sar = min(sar+af*(ep-sar), prevLow, newLow)
+			continue
+		}
+		if newHigh >= sar {
+			long = true
+			sar = min(ep, prevLow, newLow)
+			out[i] = sar
+			af = acceleration
+			ep = newHigh
+			sar = min(sar+af*(ep-sar), prevLow, newLow)
+			continue
+		}
+		out[i] = sar
+		if newLow < ep {
+			ep = newLow
+			af = min(af+acceleration, maximum)
+		}
+		sar = max(sar+af*(ep-sar), prevHigh, newHigh)
+	}
+	return out
+}
+
+// UltOsc is the ultimate oscillator. The shortest period is weighted four
+// times and the middle period twice.
+func UltOsc(high, low, close []float64, period1, period2, period3 int) []float64 {
+	periods := []int{period1, period2, period3}
+	slices.Sort(periods)
+	out := nans(len(close))
+	buyingPressure, tr := nans(len(close)), nans(len(close))
+	for i := 1; i < len(close); i++ {
+		trueLow := min(low[i], close[i-1])
+		buyingPressure[i] = close[i] - trueLow
+		tr[i] = max(high[i], close[i-1]) - trueLow
+	}
+	for i := periods[2]; i < len(close); i++ {
+		total := 0.0
+		for k, weight := range []float64{4, 2, 1} {
+			pressure, r := 0.0, 0.0
+			for j := i - periods[k] + 1; j <= i; j++ {
+				pressure += buyingPressure[j]
+				r += tr[j]
+			}
+			if !isZero(r) {
+				total += weight * pressure / r
+			}
+		}
+		out[i] = 100 * total / 7
+	}
+	return out
+}
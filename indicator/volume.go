@@ -0,0 +1,112 @@
+package indicator
+
+// MFI is the money flow index.
+func MFI(high, low, close, volume []float64, period int) []float64 {
+	out := nans(len(close))
+	positive, negative := make([]float64, len(close)), make([]float64, len(close))
+	for i := 1; i < len(close); i++ {
+		typical := (high[i] + low[i] + close[i]) / 3
+		previous := (high[i-1] + low[i-1] + close[i-1]) / 3
+		switch flow := typical * volume[i]; {
+		case typical > previous:
+			positive[i] = flow
+		case typical < previous:
+			negative[i] = flow
+		}
+	}
+	for i := period; i < len(close); i++ {
+		pos, neg := 0.0, 0.0
+		for j := i - period + 1; j <= i; j++ {
+			pos += positive[j]
+			neg += negative[j]
+		}
+		if total := pos + neg; total >= 1 {
+			out[i] = 100 * pos / total
+		} else {
+			out[i] = 0
+		}
+	}
+	return out
+}
+
+// TRIX is the one bar rate of change, in percent, of a triple exponential
+// moving average.
+func TRIX(in []float64, period int) []float64 {
+	return ROC(EMA(EMA(EMA(in, period), period), period), 1)
+}
+
+// AD is the Chaikin accumulation/distribution line.
+func AD(high, low, close, volume []float64) []float64 {
+	out := make([]float64, len(close))
+	ad := 0.0
+	for i := range out {
+		if r := high[i] - low[i]; r > 0 {
+			ad += ((close[i] - low[i]) - (high[i] - close[i])) / r * volume[i]
+		}
+		out[i] = ad
+	}
+	return out
+}
+
+// ADOSC is the Chaikin A/D oscillator: the fast minus the slow exponential
+// average of the accumulation/distribution line. Both averages start at the
+// first A/D value rather than a simple average.
+func ADOSC(high, low, close, volume []float64, fastPeriod, slowPeriod int) []float64 {
+	ad := AD(high, low, close, volume)
+	out := nans(len(close))
+	if len(ad) == 0 {
+		return out
+	}
+	fastK, slowK := 2/float64(fastPeriod+1), 2/float64(slowPeriod+1)
+	fast, slow := ad[0], ad[0]
+	for i := 1; i < len(ad); i++ {
+		fast += fastK * (ad[i] - fast)
+		slow += slowK * (ad[i] - slow)
+		if i >= max(fastPeriod, slowPeriod)-1 {
+			out[i] = fast - slow
+		}
+	}
+	return out
+}
+
+// OBV is the on balance volume starting from the first bar's volume.
+func OBV(close, volume []float64) []float64 {
+	out := make([]float64, len(close))
+	if len(close) == 0 {
+		return out
+	}
+	obv := volume[0]
+	out[0] = obv
+	for i := 1; i < len(close); i++ {
+		switch {
+		case close[i] > close[i-1]:
+			obv += volume[i]
+		case close[i] < close[i-1]:
+			obv -= volume[i]
+		}
+		out[i] = obv
+	}
+	return out
+}
+
+// VWAP is the volume weighted average of the typical price
+// ((high+low+close)/3), restarting on each calendar day of times.
+func VWAP(series Series) []float64 {
+	out := nans(series.Len())
+	var value, volume float64
+	for i, t := range series.Time {
+		if i > 0 {
+			if y, m, d := t.Date(); y != series.Time[i-1].Year() || m != series.Time[i-1].Month() || d != series.Time[i-1].Day() {
+				value, volume = 0, 0
+			}
+		}
+		value += (series.High[i] + series.Low[i] + series.Close[i]) / 3 * series.Volume[i]
+		volume += series.Volume[i]
+		if volume > 0 {
+			out[i] = value / volume
+		} else if i > 0 {
+			out[i] = out[i-1]
+		}
+	}
+	return out
+}
@@ -1,12 +1,15 @@
 package alphavantage
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
+	"maps"
 	"net/url"
+	"slices"
 
 	"github.com/portfoliotree/alphavantage/query/forex"
 	"github.com/portfoliotree/alphavantage/query/fundamental"
@@ -29,9 +32,25 @@ func (client *Client) ETFProfile(ctx context.Context, q fundamental.ETFProfileQu
 
 	var result fundamental.ETFProfile
 	err = json.Unmarshal(buf, &result)
+	result.Symbol = cmp.Or(result.Symbol, url.Values(q).Get("symbol"))
 	return result, err
 }
 
+// ETFLookThrough fetches the ETF profile for each symbol in weights and
+// combines them into the portfolio's exposure by underlying holding and sector.
+// See fundamental.LookThrough for how the profiles are aggregated.
+func (client *Client) ETFLookThrough(ctx context.Context, weights map[string]float64) (fundamental.ETFExposure, error) {
+	profiles := make([]fundamental.ETFProfile, 0, len(weights))
+	for _, symbol := range slices.Sorted(maps.Keys(weights)) {
+		profile, err := client.ETFProfile(ctx, fundamental.QueryETFProfile(client.APIKey, symbol))
+		if err != nil {
+			return fundamental.ETFExposure{}, fmt.Errorf("failed to get ETF profile for %s: %w", symbol, err)
+		}
+		profiles = append(profiles, profile)
+	}
+	return fundamental.LookThrough(profiles, weights)
+}
+
 // CompanyOverview fetches comprehensive company information for the specified symbol.
 // It returns detailed company data including financial metrics, sector information,
 // and key statistics as a CompanyOverview struct.
@@ -55,6 +74,38 @@ func (client *Client) CompanyOverview(ctx context.Context, q fundamental.Overvie
 	return result, err
 }
 
+// AnalyticsFixedWindow fetches ANALYTICS_FIXED_WINDOW statistics. The
+// analytics package computes the same statistics from stored prices.
+func (client *Client) AnalyticsFixedWindow(ctx context.Context, q intelligence.AnalyticsFixedWindowQuery) (intelligence.FixedWindowAnalytics, error) {
+	res, err := client.Query(ctx, q)
+	if err != nil {
+		return intelligence.FixedWindowAnalytics{}, err
+	}
+	defer closeAndIgnoreError(res.Body)
+
+	var result intelligence.FixedWindowAnalytics
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return intelligence.FixedWindowAnalytics{}, fmt.Errorf("failed to parse ANALYTICS_FIXED_WINDOW response: %w", err)
+	}
+	return result, nil
+}
+
+// AnalyticsSlidingWindow fetches ANALYTICS_SLIDING_WINDOW statistics. The
+// analytics package computes the same statistics from stored prices.
+func (client *Client) AnalyticsSlidingWindow(ctx context.Context, q intelligence.AnalyticsSlidingWindowQuery) (intelligence.SlidingWindowAnalytics, error) {
+	res, err := client.Query(ctx, q)
+	if err != nil {
+		return intelligence.SlidingWindowAnalytics{}, err
+	}
+	defer closeAndIgnoreError(res.Body)
+
+	var result intelligence.SlidingWindowAnalytics
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return intelligence.SlidingWindowAnalytics{}, fmt.Errorf("failed to parse ANALYTICS_SLIDING_WINDOW response: %w", err)
+	}
+	return result, nil
+}
+
 func (f *ForexFunctions) CurrencyExchangeRate(ctx context.Context, query forex.CurrencyExchangeRateQuery) (io.ReadCloser, error) {
 	res, err := (*Client)(f).Query(ctx, query)
 	if err != nil {
@@ -182,11 +233,3 @@ func (f *TimeSeriesFunctions) MarketStatus(ctx context.Context, query timeseries
 	}
 	return res.Body, nil
 }
-
-func (f *TimeSeriesFunctions) RealtimeBulkQuotes(ctx context.Context, query timeseries.RealtimeBulkQuotesQuery) (io.ReadCloser, error) {
-	res, err := (*Client)(f).Query(ctx, query)
-	if err != nil {
-		return nil, err
-	}
-	return res.Body, nil
-}
@@ -0,0 +1,239 @@
+package alphavantage
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+)
+
+// Join selects the dates of an aligned Panel.
+type Join int
+
+const (
+	// Union keeps every date found in any series. Symbols without a row on
+	// a date have NaN there.
+	Union Join = iota
+	// Intersection keeps only the dates found in every series.
+	Intersection
+)
+
+// Panel holds one value per symbol on a common date index, like the columns
+// of a data frame. Columns[i] holds the values of Symbols[i] and has one
+// value per date with NaN where the symbol has no row.
+type Panel struct {
+	Dates   []time.Time
+	Symbols []string
+	Columns [][]float64
+}
+
+// NewPanel aligns series, given in the order of symbols, on their dates. The
+// date and value functions read each row; rows may be in any order. The
+// dates of the panel are sorted from the oldest to the most recent.
+func NewPanel[R any](join Join, symbols []string, series [][]R, date func(R) time.Time, value func(R) float64) Panel {
+	type entry struct {
+		date  time.Time
+		count int
+	}
+	entries := make(map[int64]*entry)
+	values := make([]map[int64]float64, len(series))
+	for i, rows := range series {
+		values[i] = make(map[int64]float64, len(rows))
+		for _, row := range rows {
+			d := date(row)
+			key := d.UnixNano()
+			if _, ok := values[i][key]; !ok {
+				e, ok := entries[key]
+				if !ok {
+					e = &entry{date: d}
+					entries[key] = e
+				}
+				e.count++
+			}
+			values[i][key] = value(row)
+		}
+	}
+
+	keys := slices.Sorted(maps.Keys(entries))
+	if join == Intersection {
+		keys = slices.DeleteFunc(keys, func(key int64) bool { return entries[key].count < len(series) })
+	}
+	p := Panel{
+		Dates:   make([]time.Time, len(keys)),
+		Symbols: slices.Clone(symbols),
+		Columns: make([][]float64, len(series)),
+	}
+	for i, key := range keys {
+		p.Dates[i] = entries[key].date
+	}
+	for i := range series {
+		column := make([]float64, len(keys))
+		for j, key := range keys {
+			v, ok := values[i][key]
+			if !ok {
+				v = math.NaN()
+			}
+			column[j] = v
+		}
+		p.Columns[i] = column
+	}
+	return p
+}
+
+// Column returns the values of symbol or nil when the panel does not have
+// the symbol.
+func (p Panel) Column(symbol string) []float64 {
+	i := slices.Index(p.Symbols, symbol)
+	if i < 0 {
+		return nil
+	}
+	return p.Columns[i]
+}
+
+// Between returns the dates of the panel from from to to (inclusive). The
+// columns share their values with p.
+func (p Panel) Between(from, to time.Time) Panel {
+	start, _ := slices.BinarySearchFunc(p.Dates, from, time.Time.Compare)
+	end, found := slices.BinarySearchFunc(p.Dates, to, time.Time.Compare)
+	if found {
+		end++
+	}
+	end = max(start, end)
+	result := Panel{
+		Dates:   p.Dates[start:end:end],
+		Symbols: p.Symbols,
+		Columns: make([][]float64, len(p.Columns)),
+	}
+	for i, column := range p.Columns {
+		result.Columns[i] = column[start:end:end]
+	}
+	return result
+}
+
+// ForwardFill returns a copy of the panel where each NaN is replaced with the
+// most recent value before it in the same column. Values before the first
+// value of a column remain NaN.
+func (p Panel) ForwardFill() Panel {
+	filled := Panel{
+		Dates:   slices.Clone(p.Dates),
+		Symbols: slices.Clone(p.Symbols),
+		Columns: make([][]float64, len(p.Columns)),
+	}
+	for i, column := range p.Columns {
+		column = slices.Clone(column)
+		for j := 1; j < len(column); j++ {
+			if math.IsNaN(column[j]) {
+				column[j] = column[j-1]
+			}
+		}
+		filled.Columns[i] = column
+	}
+	return filled
+}
+
+// Rebase returns a copy of the panel where each column is scaled so its first
+// value is base, for example 100 to compare the growth of prices quoted in
+// different units. Columns without a value remain NaN.
+func (p Panel) Rebase(base float64) Panel {
+	rebased := Panel{
+		Dates:   slices.Clone(p.Dates),
+		Symbols: slices.Clone(p.Symbols),
+		Columns: make([][]float64, len(p.Columns)),
+	}
+	for i, column := range p.Columns {
+		column = slices.Clone(column)
+		first := slices.IndexFunc(column, func(v float64) bool { return !math.IsNaN(v) })
+		if first >= 0 {
+			scale := base / column[first]
+			for j := range column {
+				column[j] *= scale
+			}
+		}
+		rebased.Columns[i] = column
+	}
+	return rebased
+}
+
+// WriteCSV writes the panel with a "timestamp" column followed by one column
+// per symbol. Dates are written as 2006-01-02 unless a date has a time of
+// day. NaN values are written as empty fields.
+func (p Panel) WriteCSV(w io.Writer) error {
+	layout := time.DateOnly
+	for _, d := range p.Dates {
+		if h, m, s := d.Clock(); h != 0 || m != 0 || s != 0 {
+			layout = time.DateTime
+			break
+		}
+	}
+	cw := csv.NewWriter(w)
+	_ = cw.Write(append([]string{"timestamp"}, p.Symbols...))
+	record := make([]string, len(p.Columns)+1)
+	for j, d := range p.Dates {
+		record[0] = d.Format(layout)
+		for i, column := range p.Columns {
+			record[i+1] = ""
+			if !math.IsNaN(column[j]) {
+				record[i+1] = strconv.FormatFloat(column[j], 'f', -1, 64)
+			}
+		}
+		_ = cw.Write(record)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// DailyPanel fetches the TIME_SERIES_DAILY closing prices of symbols
+// concurrently and aligns them in a Panel. Each request uses query with its
+// symbol replaced and waits on the client Limiter. The first failed request
+// cancels the others.
+func (f *TimeSeriesFunctions) DailyPanel(ctx context.Context, query timeseries.DailyQuery, join Join, symbols ...string) (Panel, error) {
+	series, err := fetchEach(ctx, symbols, func(ctx context.Context, symbol string) ([]timeseries.DailyRow, error) {
+		q := maps.Clone(query)
+		url.Values(q).Set("symbol", symbol)
+		rows, err := f.Daily(ctx, q)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get daily prices for %s: %w", symbol, err)
+		}
+		return rows, nil
+	})
+	if err != nil {
+		return Panel{}, err
+	}
+	return NewPanel(join, symbols, series,
+		func(row timeseries.DailyRow) time.Time { return row.TimeStamp },
+		func(row timeseries.DailyRow) float64 { return row.Close },
+	), nil
+}
+
+// fetchEach calls fetch for each key concurrently and returns the results in
+// the order of keys. The first error cancels the other calls.
+func fetchEach[R any](ctx context.Context, keys []string, fetch func(ctx context.Context, key string) ([]R, error)) ([][]R, error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	results := make([][]R, len(keys))
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Go(func() {
+			rows, err := fetch(ctx, key)
+			if err != nil {
+				cancel(err)
+				return
+			}
+			results[i] = rows
+		})
+	}
+	wg.Wait()
+	if err := context.Cause(ctx); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
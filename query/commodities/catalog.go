@@ -0,0 +1,121 @@
+package commodities
+
+import (
+	"slices"
+	"time"
+)
+
+// Commodity is the function name of a commodity price series.
+type Commodity string
+
+const (
+	WestTexasIntermediate Commodity = "WTI"
+	Brent                 Commodity = "BRENT"
+	NaturalGas            Commodity = "NATURAL_GAS"
+	Copper                Commodity = "COPPER"
+	Aluminum              Commodity = "ALUMINUM"
+	Wheat                 Commodity = "WHEAT"
+	Corn                  Commodity = "CORN"
+	Cotton                Commodity = "COTTON"
+	Sugar                 Commodity = "SUGAR"
+	Coffee                Commodity = "COFFEE"
+	All                   Commodity = "ALL_COMMODITIES"
+)
+
+// Metadata describes a commodity price series.
+type Metadata struct {
+	Commodity Commodity
+	Name      string
+	// Unit is what a price is quoted in, such as "dollars per barrel".
+	Unit string
+	// Currency is the ISO 4217 code of the currency of the prices. It is
+	// empty for the index, which has no currency.
+	Currency string
+	// Source is the series the data comes from, such as a FRED series ID.
+	Source string
+	// Intervals lists the values of the interval parameter with the default
+	// first.
+	Intervals []string
+}
+
+// Catalog describes every commodity series.
+var Catalog = []Metadata{
+	{
+		Commodity: WestTexasIntermediate, Name: "Crude Oil Prices: West Texas Intermediate",
+		Unit: "dollars per barrel", Currency: "USD", Source: "FRED DCOILWTICO",
+		Intervals: []string{"monthly", "weekly", "daily"},
+	},
+	{
+		Commodity: Brent, Name: "Crude Oil Prices: Brent - Europe",
+		Unit: "dollars per barrel", Currency: "USD", Source: "FRED DCOILBRENTEU",
+		Intervals: []string{"monthly", "weekly", "daily"},
+	},
+	{
+		Commodity: NaturalGas, Name: "Henry Hub Natural Gas Spot Price",
+		Unit: "dollars per million BTU", Currency: "USD", Source: "FRED DHHNGSP",
+		Intervals: []string{"monthly", "weekly", "daily"},
+	},
+	{
+		Commodity: Copper, Name: "Global price of Copper",
+		Unit: "dollars per metric ton", Currency: "USD", Source: "FRED PCOPPUSDM",
+		Intervals: []string{"monthly", "quarterly", "annual"},
+	},
+	{
+		Commodity: Aluminum, Name: "Global price of Aluminum",
+		Unit: "dollars per metric ton", Currency: "USD", Source: "FRED PALUMUSDM",
+		Intervals: []string{"monthly", "quarterly", "annual"},
+	},
+	{
+		Commodity: Wheat, Name: "Global price of Wheat",
+		Unit: "dollars per metric ton", Currency: "USD", Source: "FRED PWHEAMTUSDM",
+		Intervals: []string{"monthly", "quarterly", "annual"},
+	},
+	{
+		Commodity: Corn, Name: "Global price of Corn",
+		Unit: "dollars per metric ton", Currency: "USD", Source: "FRED PMAIZMTUSDM",
+		Intervals: []string{"monthly", "quarterly", "annual"},
+	},
+	{
+		Commodity: Cotton, Name: "Global price of Cotton",
+		Unit: "cents per pound", Currency: "USD", Source: "FRED PCOTTINDUSDM",
+		Intervals: []string{"monthly", "quarterly", "annual"},
+	},
+	{
+		Commodity: Sugar, Name: "Global price of Sugar, No. 11, World",
+		Unit: "cents per pound", Currency: "USD", Source: "FRED PSUGAISAUSDM",
+		Intervals: []string{"monthly", "quarterly", "annual"},
+	},
+	{
+		Commodity: Coffee, Name: "Global price of Coffee, Other Mild Arabica",
+		Unit: "cents per pound", Currency: "USD", Source: "FRED PCOFFOTMUSDM",
+		Intervals: []string{"monthly", "quarterly", "annual"},
+	},
+	{
+		Commodity: All, Name: "Global Price Index of All Commodities",
+		Unit: "index 2016=100", Source: "FRED PALLFNFINDEXM",
+		Intervals: []string{"monthly", "quarterly", "annual"},
+	},
+}
+
+// Metadata returns the catalog entry of the commodity.
+func (c Commodity) Metadata() (Metadata, bool) {
+	i := slices.IndexFunc(Catalog, func(m Metadata) bool { return m.Commodity == c })
+	if i < 0 {
+		return Metadata{}, false
+	}
+	return Catalog[i], true
+}
+
+// AcceptsInterval reports whether interval can be requested. The empty
+// interval requests the default interval.
+func (m Metadata) AcceptsInterval(interval string) bool {
+	return interval == "" || slices.Contains(m.Intervals, interval)
+}
+
+// Observation is a commodity price. Missing is true when the source has no
+// price for the date, which AlphaVantage sends as ".".
+type Observation struct {
+	Date    time.Time
+	Value   float64
+	Missing bool
+}
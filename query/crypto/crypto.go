@@ -49,10 +49,10 @@ func (q IntradayQuery) Encode() string {
 
 type IntradayRow struct {
 	TimeStamp time.Time `column-name:"timestamp" time-layout:"2006-01-02 15:04:05"`
-	Open      float64   `column-name:"open"`
-	High      float64   `column-name:"high"`
-	Low       float64   `column-name:"low"`
-	Close     float64   `column-name:"close"`
+	Open      float64   `column-name:"open" decimals:"5"`
+	High      float64   `column-name:"high" decimals:"5"`
+	Low       float64   `column-name:"low" decimals:"5"`
+	Close     float64   `column-name:"close" decimals:"5"`
 	Volume    float64   `column-name:"volume"`
 }
 
@@ -68,11 +68,11 @@ func (q DigitalCurrencyDailyQuery) Encode() string {
 
 type DigitalCurrencyDailyRow struct {
 	TimeStamp time.Time `column-name:"timestamp" time-layout:"2006-01-02"`
-	Open      float64   `column-name:"open"`
-	High      float64   `column-name:"high"`
-	Low       float64   `column-name:"low"`
-	Close     float64   `column-name:"close"`
-	Volume    float64   `column-name:"volume"`
+	Open      float64   `column-name:"open" decimals:"8"`
+	High      float64   `column-name:"high" decimals:"8"`
+	Low       float64   `column-name:"low" decimals:"8"`
+	Close     float64   `column-name:"close" decimals:"8"`
+	Volume    float64   `column-name:"volume" decimals:"8"`
 }
 
 type DigitalCurrencyMonthlyQuery url.Values
@@ -87,11 +87,11 @@ func (q DigitalCurrencyMonthlyQuery) Encode() string {
 
 type DigitalCurrencyMonthlyRow struct {
 	TimeStamp time.Time `column-name:"timestamp" time-layout:"2006-01-02"`
-	Open      float64   `column-name:"open"`
-	High      float64   `column-name:"high"`
-	Low       float64   `column-name:"low"`
-	Close     float64   `column-name:"close"`
-	Volume    float64   `column-name:"volume"`
+	Open      float64   `column-name:"open" decimals:"8"`
+	High      float64   `column-name:"high" decimals:"8"`
+	Low       float64   `column-name:"low" decimals:"8"`
+	Close     float64   `column-name:"close" decimals:"8"`
+	Volume    float64   `column-name:"volume" decimals:"8"`
 }
 
 type DigitalCurrencyWeeklyQuery url.Values
@@ -106,9 +106,9 @@ func (q DigitalCurrencyWeeklyQuery) Encode() string {
 
 type DigitalCurrencyWeeklyRow struct {
 	TimeStamp time.Time `column-name:"timestamp" time-layout:"2006-01-02"`
-	Open      float64   `column-name:"open"`
-	High      float64   `column-name:"high"`
-	Low       float64   `column-name:"low"`
-	Close     float64   `column-name:"close"`
-	Volume    float64   `column-name:"volume"`
+	Open      float64   `column-name:"open" decimals:"8"`
+	High      float64   `column-name:"high" decimals:"8"`
+	Low       float64   `column-name:"low" decimals:"8"`
+	Close     float64   `column-name:"close" decimals:"8"`
+	Volume    float64   `column-name:"volume" decimals:"8"`
 }
@@ -0,0 +1,176 @@
+package economic
+
+import (
+	"slices"
+	"time"
+)
+
+// Indicator is the function name of an economic indicator.
+type Indicator string
+
+const (
+	RealGDP          Indicator = "REAL_GDP"
+	RealGDPPerCapita Indicator = "REAL_GDP_PER_CAPITA"
+	TreasuryYield    Indicator = "TREASURY_YIELD"
+	FederalFundsRate Indicator = "FEDERAL_FUNDS_RATE"
+	CPI              Indicator = "CPI"
+	Inflation        Indicator = "INFLATION"
+	RetailSales      Indicator = "RETAIL_SALES"
+	Durables         Indicator = "DURABLES"
+	Unemployment     Indicator = "UNEMPLOYMENT"
+	NonfarmPayroll   Indicator = "NONFARM_PAYROLL"
+)
+
+// Metadata describes an economic indicator.
+type Metadata struct {
+	Indicator Indicator
+	Name      string
+	Unit      string
+	// Intervals lists the values of the interval parameter with the default
+	// first. It is nil when the function does not accept an interval.
+	Intervals []string
+	// Frequency is the interval of the series when no interval is requested.
+	Frequency          string
+	SeasonallyAdjusted bool
+	// Source is the series the data comes from, such as a FRED series ID.
+	Source string
+	// ReleaseDay is the day of the month after the end of a period by which
+	// its observation is usually published. It is 0 for daily series and
+	// series without a regular release schedule.
+	ReleaseDay int
+}
+
+// Catalog describes every economic indicator.
+var Catalog = []Metadata{
+	{
+		Indicator: RealGDP, Name: "Real Gross Domestic Product", Unit: "billions of chained 2017 dollars",
+		Intervals: []string{"annual", "quarterly"}, Frequency: "annual",
+		SeasonallyAdjusted: true, Source: "FRED GDPC1", ReleaseDay: 30,
+	},
+	{
+		Indicator: RealGDPPerCapita, Name: "Real GDP per Capita", Unit: "chained 2017 dollars",
+		Frequency:          "quarterly",
+		SeasonallyAdjusted: true, Source: "FRED A939RX0Q048SBEA", ReleaseDay: 30,
+	},
+	{
+		Indicator: TreasuryYield, Name: "Treasury Yield", Unit: "percent",
+		Intervals: []string{"monthly", "weekly", "daily"}, Frequency: "monthly",
+		Source: "FRED DGS10",
+	},
+	{
+		Indicator: FederalFundsRate, Name: "Federal Funds Effective Rate", Unit: "percent",
+		Intervals: []string{"monthly", "weekly", "daily"}, Frequency: "monthly",
+		Source: "FRED FEDFUNDS",
+	},
+	{
+		Indicator: CPI, Name: "Consumer Price Index for All Urban Consumers", Unit: "index 1982-1984=100",
+		Intervals: []string{"monthly", "semiannual"}, Frequency: "monthly",
+		Source: "FRED CPIAUCNS", ReleaseDay: 15,
+	},
+	{
+		Indicator: Inflation, Name: "Inflation, consumer prices", Unit: "percent",
+		Frequency: "annual",
+		Source:    "World Bank FPCPITOTLZGUSA",
+	},
+	{
+		Indicator: RetailSales, Name: "Advance Retail Sales: Retail Trade", Unit: "millions of dollars",
+		Frequency: "monthly",
+		Source:    "FRED RSXFSN", ReleaseDay: 17,
+	},
+	{
+		Indicator: Durables, Name: "Manufacturers' New Orders: Durable Goods", Unit: "millions of dollars",
+		Frequency: "monthly",
+		Source:    "FRED UMDMNO", ReleaseDay: 28,
+	},
+	{
+		Indicator: Unemployment, Name: "Unemployment Rate", Unit: "percent",
+		Frequency:          "monthly",
+		SeasonallyAdjusted: true, Source: "FRED UNRATE", ReleaseDay: 7,
+	},
+	{
+		Indicator: NonfarmPayroll, Name: "All Employees, Total Nonfarm", Unit: "thousands of persons",
+		Frequency: "monthly",
+		Source:    "FRED PAYNSA", ReleaseDay: 7,
+	},
+}
+
+// Metadata returns the catalog entry of the indicator.
+func (ind Indicator) Metadata() (Metadata, bool) {
+	i := slices.IndexFunc(Catalog, func(m Metadata) bool { return m.Indicator == ind })
+	if i < 0 {
+		return Metadata{}, false
+	}
+	return Catalog[i], true
+}
+
+// AcceptsInterval reports whether interval can be requested. The empty
+// interval requests the default frequency.
+func (m Metadata) AcceptsInterval(interval string) bool {
+	return interval == "" || slices.Contains(m.Intervals, interval)
+}
+
+// NextRelease estimates when the observation of the period after the one
+// dated last is published. The interval defaults to Frequency. It is the
+// zero time for series without a ReleaseDay.
+func (m Metadata) NextRelease(last time.Time, interval string) time.Time {
+	if interval == "" {
+		interval = m.Frequency
+	}
+	months := periodMonths(interval)
+	if m.ReleaseDay == 0 || months == 0 {
+		return time.Time{}
+	}
+	end := time.Date(last.Year(), last.Month()+time.Month(2*months), 1, 0, 0, 0, 0, last.Location())
+	return time.Date(end.Year(), end.Month(), m.ReleaseDay, 0, 0, 0, 0, last.Location())
+}
+
+func periodMonths(interval string) int {
+	switch interval {
+	case "monthly":
+		return 1
+	case "quarterly":
+		return 3
+	case "semiannual":
+		return 6
+	case "annual":
+		return 12
+	}
+	return 0
+}
+
+// Observation is a value of an economic indicator. Missing is true when the
+// source has no value for the date, which AlphaVantage sends as ".".
+type Observation struct {
+	Date    time.Time
+	Value   float64
+	Missing bool
+}
+
+// YoY returns the percent change of each observation from the observation
+// dated one year earlier. The change is missing when either observation is
+// missing or there is no observation a year earlier.
+func YoY(observations []Observation) []Observation {
+	return percentChange(observations, func(d time.Time) time.Time { return d.AddDate(-1, 0, 0) })
+}
+
+// MoM returns the percent change of each observation from the observation
+// dated one month earlier, like YoY.
+func MoM(observations []Observation) []Observation {
+	return percentChange(observations, func(d time.Time) time.Time { return d.AddDate(0, -1, 0) })
+}
+
+func percentChange(observations []Observation, earlier func(time.Time) time.Time) []Observation {
+	byDate := make(map[int64]Observation, len(observations))
+	for _, o := range observations {
+		byDate[o.Date.UnixNano()] = o
+	}
+	changes := make([]Observation, len(observations))
+	for i, o := range observations {
+		prev, ok := byDate[earlier(o.Date).UnixNano()]
+		changes[i] = Observation{Date: o.Date, Missing: true}
+		if ok && !o.Missing && !prev.Missing && prev.Value != 0 {
+			changes[i] = Observation{Date: o.Date, Value: (o.Value/prev.Value - 1) * 100}
+		}
+	}
+	return changes
+}
@@ -2,7 +2,10 @@
 
 package economic
 
-import "net/url"
+import (
+	"net/url"
+	"time"
+)
 
 type ConsumerPriceIndexQuery url.Values
 
@@ -295,8 +298,8 @@ func (q TreasuryYieldQuery) Encode() string {
 }
 
 type TreasuryYieldRow struct {
-	TimeStamp string `column-name:"timestamp"`
-	Value     string `column-name:"value"`
+	TimeStamp time.Time `column-name:"timestamp" time-layout:"2006-01-02"`
+	Value     float64   `column-name:"value" decimals:"2"`
 }
 
 type UnemploymentQuery url.Values
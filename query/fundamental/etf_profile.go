@@ -1,24 +1,227 @@
 package fundamental
 
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/portfoliotree/alphavantage/api"
+)
+
+// ETFProfile contains fund level metrics and the sector and holding
+// breakdown returned by the AlphaVantage ETF_PROFILE function.
+// Ratios and weights are fractions (0.076 means 7.6%).
 type ETFProfile struct {
 	Symbol            string       `json:"symbol,omitempty"`
-	NetAssets         string       `json:"net_assets,omitempty"`
-	NetExpenseRatio   string       `json:"net_expense_ratio,omitempty"`
-	PortfolioTurnover string       `json:"portfolio_turnover,omitempty"`
-	DividendYield     string       `json:"dividend_yield,omitempty"`
-	InceptionDate     string       `json:"inception_date,omitempty"`
+	NetAssets         float64      `json:"net_assets,omitempty"`
+	NetExpenseRatio   float64      `json:"net_expense_ratio,omitempty"`
+	PortfolioTurnover float64      `json:"portfolio_turnover,omitempty"`
+	DividendYield     float64      `json:"dividend_yield,omitempty"`
+	InceptionDate     time.Time    `json:"inception_date,omitempty"`
 	Leveraged         string       `json:"leveraged,omitempty"`
 	Sectors           []ETFSector  `json:"sectors,omitempty"`
 	Holdings          []ETFHolding `json:"holdings,omitempty"`
 }
 
 type ETFSector struct {
-	Sector string `json:"sector,omitempty"`
-	Weight string `json:"weight,omitempty"`
+	Sector string  `json:"sector,omitempty"`
+	Weight float64 `json:"weight,omitempty"`
 }
 
 type ETFHolding struct {
-	Symbol      string `json:"symbol,omitempty"`
-	Description string `json:"description,omitempty"`
-	Weight      string `json:"weight,omitempty"`
+	Symbol      string  `json:"symbol,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Weight      float64 `json:"weight,omitempty"`
+}
+
+func (p *ETFProfile) UnmarshalJSON(in []byte) error {
+	var data struct {
+		Symbol            string       `json:"symbol"`
+		NetAssets         etfValue     `json:"net_assets"`
+		NetExpenseRatio   etfValue     `json:"net_expense_ratio"`
+		PortfolioTurnover etfValue     `json:"portfolio_turnover"`
+		DividendYield     etfValue     `json:"dividend_yield"`
+		InceptionDate     etfValue     `json:"inception_date"`
+		Leveraged         string       `json:"leveraged"`
+		Sectors           []ETFSector  `json:"sectors"`
+		Holdings          []ETFHolding `json:"holdings"`
+	}
+	if err := json.Unmarshal(in, &data); err != nil {
+		return err
+	}
+	result := ETFProfile{
+		Symbol:    data.Symbol,
+		Leveraged: data.Leveraged,
+		Sectors:   data.Sectors,
+		Holdings:  data.Holdings,
+	}
+	for _, field := range []struct {
+		key   string
+		value etfValue
+		dst   *float64
+	}{
+		{key: "net_assets", value: data.NetAssets, dst: &result.NetAssets},
+		{key: "net_expense_ratio", value: data.NetExpenseRatio, dst: &result.NetExpenseRatio},
+		{key: "portfolio_turnover", value: data.PortfolioTurnover, dst: &result.PortfolioTurnover},
+		{key: "dividend_yield", value: data.DividendYield, dst: &result.DividendYield},
+	} {
+		f, err := parseETFNumber(field.value)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", field.key, err)
+		}
+		*field.dst = f
+	}
+	if v := string(data.InceptionDate); !isETFMissingValue(v) {
+		t, err := time.ParseInLocation(api.DefaultDateFormat, v, time.UTC)
+		if err != nil {
+			var rfcErr error
+			if t, rfcErr = time.Parse(time.RFC3339, v); rfcErr != nil {
+				return fmt.Errorf("failed to parse inception_date: %w", err)
+			}
+		}
+		result.InceptionDate = t
+	}
+	*p = result
+	return nil
+}
+
+func (s *ETFSector) UnmarshalJSON(in []byte) error {
+	var data struct {
+		Sector string   `json:"sector"`
+		Weight etfValue `json:"weight"`
+	}
+	if err := json.Unmarshal(in, &data); err != nil {
+		return err
+	}
+	weight, err := parseETFNumber(data.Weight)
+	if err != nil {
+		return fmt.Errorf("failed to parse weight for sector %s: %w", data.Sector, err)
+	}
+	*s = ETFSector{Sector: data.Sector, Weight: weight}
+	return nil
+}
+
+func (h *ETFHolding) UnmarshalJSON(in []byte) error {
+	var data struct {
+		Symbol      string   `json:"symbol"`
+		Description string   `json:"description"`
+		Weight      etfValue `json:"weight"`
+	}
+	if err := json.Unmarshal(in, &data); err != nil {
+		return err
+	}
+	weight, err := parseETFNumber(data.Weight)
+	if err != nil {
+		return fmt.Errorf("failed to parse weight for holding %s: %w", data.Symbol, err)
+	}
+	*h = ETFHolding{Symbol: data.Symbol, Description: data.Description, Weight: weight}
+	return nil
+}
+
+func parseETFNumber(v etfValue) (float64, error) {
+	if isETFMissingValue(string(v)) {
+		return 0, nil
+	}
+	return strconv.ParseFloat(string(v), 64)
+}
+
+func isETFMissingValue(v string) bool {
+	switch v {
+	case "", "n/a", "None", "null":
+		return true
+	}
+	return false
+}
+
+// ETFExposure is the combined look-through exposure of a portfolio of ETFs.
+// Weights are fractions of the whole portfolio.
+type ETFExposure struct {
+	// Holdings is sorted by descending weight.
+	Holdings []ETFHolding
+	// Sectors is sorted by descending weight.
+	Sectors []ETFSector
+	// Unlisted is the portfolio weight held by the funds but not covered by
+	// the reported holdings (for example when a profile lists only the largest positions).
+	Unlisted float64
+}
+
+// LookThrough combines the holdings and sectors of each profile scaled by the
+// portfolio weight keyed by the profile symbol. Holdings without a symbol
+// (AlphaVantage reports cash as "n/a") are grouped by description.
+// It returns an error if a weighted symbol has no profile.
+func LookThrough(profiles []ETFProfile, weights map[string]float64) (ETFExposure, error) {
+	bySymbol := make(map[string]ETFProfile, len(profiles))
+	for _, p := range profiles {
+		bySymbol[p.Symbol] = p
+	}
+
+	holdings := make(map[string]ETFHolding)
+	sectors := make(map[string]float64)
+	var exposure ETFExposure
+	for _, symbol := range slices.Sorted(maps.Keys(weights)) {
+		weight := weights[symbol]
+		p, ok := bySymbol[symbol]
+		if !ok {
+			return ETFExposure{}, fmt.Errorf("missing ETF profile for %s", symbol)
+		}
+		listed := 0.0
+		for _, h := range p.Holdings {
+			key := h.Symbol
+			if isETFMissingValue(key) {
+				key = h.Description
+			}
+			acc, ok := holdings[key]
+			if !ok {
+				acc = ETFHolding{Symbol: h.Symbol, Description: h.Description}
+			}
+			if isETFMissingValue(acc.Description) {
+				acc.Description = h.Description
+			}
+			acc.Weight += weight * h.Weight
+			holdings[key] = acc
+			listed += h.Weight
+		}
+		if listed < 1 {
+			exposure.Unlisted += weight * (1 - listed)
+		}
+		for _, s := range p.Sectors {
+			sectors[s.Sector] += weight * s.Weight
+		}
+	}
+
+	for _, h := range holdings {
+		exposure.Holdings = append(exposure.Holdings, h)
+	}
+	slices.SortFunc(exposure.Holdings, func(a, b ETFHolding) int {
+		return cmp.Or(cmp.Compare(b.Weight, a.Weight), cmp.Compare(a.Symbol, b.Symbol), cmp.Compare(a.Description, b.Description))
+	})
+	for sector, w := range sectors {
+		exposure.Sectors = append(exposure.Sectors, ETFSector{Sector: sector, Weight: w})
+	}
+	slices.SortFunc(exposure.Sectors, func(a, b ETFSector) int {
+		return cmp.Or(cmp.Compare(b.Weight, a.Weight), cmp.Compare(a.Sector, b.Sector))
+	})
+	return exposure, nil
+}
+
+// etfValue holds a scalar from an ETF profile document. AlphaVantage quotes
+// every value; etfValue also accepts bare numbers so a marshaled ETFProfile
+// can be decoded again.
+type etfValue string
+
+func (v *etfValue) UnmarshalJSON(in []byte) error {
+	if len(in) > 0 && in[0] == '"' {
+		var s string
+		if err := json.Unmarshal(in, &s); err != nil {
+			return err
+		}
+		*v = etfValue(s)
+		return nil
+	}
+	*v = etfValue(in)
+	return nil
 }
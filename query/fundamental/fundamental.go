@@ -2,7 +2,11 @@
 
 package fundamental
 
-import "net/url"
+import (
+	"github.com/portfoliotree/alphavantage/api"
+	"net/url"
+	"time"
+)
 
 type BalanceSheetQuery url.Values
 
@@ -50,11 +54,11 @@ func (q DividendsQuery) Encode() string {
 }
 
 type DividendsRow struct {
-	ExDividendDate  string `column-name:"ex_dividend_date"`
-	DeclarationDate string `column-name:"declaration_date"`
-	RecordDate      string `column-name:"record_date"`
-	PaymentDate     string `column-name:"payment_date"`
-	Amount          string `column-name:"amount"`
+	ExDividendDate  time.Time `column-name:"ex_dividend_date" time-layout:"2006-01-02"`
+	DeclarationDate time.Time `column-name:"declaration_date" time-layout:"2006-01-02" null:"None"`
+	RecordDate      time.Time `column-name:"record_date" time-layout:"2006-01-02" null:"None"`
+	PaymentDate     time.Time `column-name:"payment_date" time-layout:"2006-01-02" null:"None"`
+	Amount          float64   `column-name:"amount" decimals:"1"`
 }
 
 type ETFProfileQuery url.Values
@@ -254,6 +258,6 @@ func (q SplitsQuery) Encode() string {
 }
 
 type SplitsRow struct {
-	EffectiveDate string `column-name:"effective_date"`
-	SplitFactor   string `column-name:"split_factor"`
+	EffectiveDate time.Time `column-name:"effective_date" time-layout:"2006-01-02"`
+	SplitFactor   api.Ratio `column-name:"split_factor" decimals:"4"`
 }
@@ -0,0 +1,535 @@
+package intelligence
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/portfoliotree/alphavantage/api"
+)
+
+// Calculation is a statistic requested with the CALCULATIONS parameter of
+// ANALYTICS_FIXED_WINDOW and ANALYTICS_SLIDING_WINDOW. The statistics are
+// computed from the returns of each symbol. Parameters are written in
+// parentheses, for example "STDDEV(annualized=True)".
+type Calculation string
+
+const (
+	Mean             Calculation = "MEAN"
+	StdDev           Calculation = "STDDEV"
+	Variance         Calculation = "VARIANCE"
+	MaxDrawdown      Calculation = "MAX_DRAWDOWN"
+	Correlation      Calculation = "CORRELATION"
+	Covariance       Calculation = "COVARIANCE"
+	Autocorrelation  Calculation = "AUTOCORRELATION"
+	CumulativeReturn Calculation = "CUMULATIVE_RETURN"
+)
+
+// Annualized returns the calculation with annualized=True, which
+// AlphaVantage accepts for STDDEV and VARIANCE.
+func (c Calculation) Annualized() Calculation {
+	return c.with("annualized", "True")
+}
+
+// Lag returns the calculation with the lag parameter of AUTOCORRELATION.
+func (c Calculation) Lag(n int) Calculation {
+	return c.with("lag", strconv.Itoa(n))
+}
+
+func (c Calculation) with(name, value string) Calculation {
+	params := c.params()
+	params = append(params, [2]string{name, value})
+	parts := make([]string, len(params))
+	for i, p := range params {
+		parts[i] = p[0] + "=" + p[1]
+	}
+	return c.Name() + Calculation("("+strings.Join(parts, ",")+")")
+}
+
+// Name returns the calculation without its parameters.
+func (c Calculation) Name() Calculation {
+	name, _, _ := strings.Cut(string(c), "(")
+	return Calculation(strings.ToUpper(strings.TrimSpace(name)))
+}
+
+// Param returns the value of the named parameter. Names are compared
+// without case since responses write them in upper case.
+func (c Calculation) Param(name string) (string, bool) {
+	for _, p := range c.params() {
+		if strings.EqualFold(p[0], name) {
+			return p[1], true
+		}
+	}
+	return "", false
+}
+
+func (c Calculation) params() [][2]string {
+	_, rest, ok := strings.Cut(string(c), "(")
+	if !ok {
+		return nil
+	}
+	var params [][2]string
+	for p := range strings.SplitSeq(strings.TrimSuffix(rest, ")"), ",") {
+		name, value, _ := strings.Cut(p, "=")
+		if name = strings.TrimSpace(name); name != "" {
+			params = append(params, [2]string{name, strings.TrimSpace(value)})
+		}
+	}
+	return params
+}
+
+// IsAnnualized reports whether the calculation has annualized=True.
+func (c Calculation) IsAnnualized() bool {
+	v, _ := c.Param("annualized")
+	return strings.EqualFold(v, "true")
+}
+
+// joinCalculations formats the CALCULATIONS parameter. It is a set, so
+// repeated calculations are written once.
+func joinCalculations(calculations []Calculation) string {
+	s := make([]string, 0, len(calculations))
+	for _, c := range calculations {
+		if !slices.Contains(s, string(c)) {
+			s = append(s, string(c))
+		}
+	}
+	return strings.Join(s, ",")
+}
+
+// Calculations sets the CALCULATIONS parameter.
+func (query AnalyticsFixedWindowQuery) Calculations(calculations ...Calculation) AnalyticsFixedWindowQuery {
+	query["CALCULATIONS"] = []string{joinCalculations(calculations)}
+	return query
+}
+
+// AnalyticsMetaData describes the request an analytics response was computed
+// for.
+type AnalyticsMetaData struct {
+	Symbols  []string
+	MinDate  time.Time
+	MaxDate  time.Time
+	OHLC     string
+	Interval string
+	// WindowSize is only set for ANALYTICS_SLIDING_WINDOW.
+	WindowSize int
+}
+
+func (m *AnalyticsMetaData) UnmarshalJSON(in []byte) error {
+	var data struct {
+		Symbols    string `json:"symbols"`
+		MinDate    string `json:"min_dt"`
+		MaxDate    string `json:"max_dt"`
+		OHLC       string `json:"ohlc"`
+		Interval   string `json:"interval"`
+		WindowSize int    `json:"window_size"`
+	}
+	if err := json.Unmarshal(in, &data); err != nil {
+		return err
+	}
+	result := AnalyticsMetaData{
+		OHLC:       data.OHLC,
+		Interval:   data.Interval,
+		WindowSize: data.WindowSize,
+	}
+	if data.Symbols != "" {
+		result.Symbols = strings.Split(data.Symbols, ",")
+	}
+	for _, field := range []struct {
+		key, value string
+		dst        *time.Time
+	}{
+		{key: "min_dt", value: data.MinDate, dst: &result.MinDate},
+		{key: "max_dt", value: data.MaxDate, dst: &result.MaxDate},
+	} {
+		if field.value == "" {
+			continue
+		}
+		t, err := parseAnalyticsTime(field.value)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", field.key, err)
+		}
+		*field.dst = t
+	}
+	*m = result
+	return nil
+}
+
+// Drawdown is the largest peak to trough decline of a symbol, as a negative
+// fraction, with the dates of the peak and the trough.
+type Drawdown struct {
+	MaxDrawdown float64
+	Start       time.Time
+	End         time.Time
+}
+
+func (d *Drawdown) UnmarshalJSON(in []byte) error {
+	var value float64
+	if err := json.Unmarshal(in, &value); err == nil {
+		*d = Drawdown{MaxDrawdown: value}
+		return nil
+	}
+	var data struct {
+		MaxDrawdown float64 `json:"max_drawdown"`
+		Range       struct {
+			Start string `json:"start_drawdown"`
+			End   string `json:"end_drawdown"`
+		} `json:"drawdown_range"`
+	}
+	if err := json.Unmarshal(in, &data); err != nil {
+		return err
+	}
+	result := Drawdown{MaxDrawdown: data.MaxDrawdown}
+	var err error
+	if data.Range.Start != "" {
+		if result.Start, err = parseAnalyticsTime(data.Range.Start); err != nil {
+			return fmt.Errorf("failed to parse start_drawdown: %w", err)
+		}
+	}
+	if data.Range.End != "" {
+		if result.End, err = parseAnalyticsTime(data.Range.End); err != nil {
+			return fmt.Errorf("failed to parse end_drawdown: %w", err)
+		}
+	}
+	*d = result
+	return nil
+}
+
+// Matrix is a symmetric matrix such as CORRELATION or COVARIANCE.
+// AlphaVantage sends the lower triangle: Values[i] holds the entries of
+// Index[i] with Index[0] through Index[i].
+type Matrix struct {
+	Index  []string
+	Values [][]float64
+}
+
+// At returns the entry for symbols a and b in either order.
+func (m Matrix) At(a, b string) (float64, bool) {
+	i, j := -1, -1
+	for k, symbol := range m.Index {
+		if symbol == a {
+			i = k
+		}
+		if symbol == b {
+			j = k
+		}
+	}
+	if i < 0 || j < 0 {
+		return 0, false
+	}
+	if j > i {
+		i, j = j, i
+	}
+	if i >= len(m.Values) || j >= len(m.Values[i]) {
+		return 0, false
+	}
+	return m.Values[i][j], true
+}
+
+func (m *Matrix) unmarshal(in []byte) error {
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(in, &data); err != nil {
+		return err
+	}
+	var result Matrix
+	for key, value := range data {
+		var err error
+		if key == "index" {
+			err = json.Unmarshal(value, &result.Index)
+		} else {
+			err = json.Unmarshal(value, &result.Values)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", key, err)
+		}
+	}
+	*m = result
+	return nil
+}
+
+// FixedWindowAnalytics is the response of ANALYTICS_FIXED_WINDOW. Each
+// calculation that was not requested is nil. Per symbol statistics are keyed
+// by symbol. When a calculation is requested with different parameters, such
+// as STDDEV and STDDEV(annualized=True), the field holds the last one in
+// Calculations.
+type FixedWindowAnalytics struct {
+	MetaData AnalyticsMetaData
+
+	// Calculations lists the calculations in the response with their
+	// parameters, for example "STDDEV(ANNUALIZED=TRUE)".
+	Calculations []Calculation
+
+	Mean             map[string]float64
+	StdDev           map[string]float64
+	Variance         map[string]float64
+	CumulativeReturn map[string]float64
+	Autocorrelation  map[string]float64
+	MaxDrawdown      map[string]Drawdown
+	Correlation      *Matrix
+	Covariance       *Matrix
+
+	// Other holds calculations without a typed field, such as HISTOGRAM.
+	Other map[Calculation]json.RawMessage
+}
+
+func (a *FixedWindowAnalytics) UnmarshalJSON(in []byte) error {
+	meta, calculations, values, err := decodeAnalytics(in)
+	if err != nil {
+		return err
+	}
+	result := FixedWindowAnalytics{MetaData: meta, Calculations: calculations}
+	for _, calculation := range calculations {
+		if err := result.set(calculation, values[calculation]); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", calculation, err)
+		}
+	}
+	*a = result
+	return nil
+}
+
+// decodeAnalytics returns the meta data and the calculations of an analytics
+// response, with the calculations sorted.
+func decodeAnalytics(in []byte) (AnalyticsMetaData, []Calculation, map[Calculation]json.RawMessage, error) {
+	var data struct {
+		MetaData     AnalyticsMetaData                          `json:"meta_data"`
+		Payload      map[string]map[Calculation]json.RawMessage `json:"payload"`
+		Information  string                                     `json:"Information"`
+		ErrorMessage string                                     `json:"Error Message"`
+	}
+	if err := json.Unmarshal(in, &data); err != nil {
+		return AnalyticsMetaData{}, nil, nil, err
+	}
+	if data.Payload == nil {
+		if notice := strings.TrimSpace(data.ErrorMessage + " " + data.Information); notice != "" {
+			if strings.Contains(notice, "premium endpoint") {
+				return AnalyticsMetaData{}, nil, nil, fmt.Errorf("%w: %s", api.ErrPremiumEndpoint, notice)
+			}
+			return AnalyticsMetaData{}, nil, nil, errors.New(notice)
+		}
+	}
+	values := make(map[Calculation]json.RawMessage)
+	for _, group := range data.Payload {
+		maps.Copy(values, group)
+	}
+	return data.MetaData, slices.Sorted(maps.Keys(values)), values, nil
+}
+
+func (a *FixedWindowAnalytics) set(calculation Calculation, value json.RawMessage) error {
+	var dst *map[string]float64
+	switch calculation.Name() {
+	case Mean:
+		dst = &a.Mean
+	case StdDev:
+		dst = &a.StdDev
+	case Variance:
+		dst = &a.Variance
+	case CumulativeReturn:
+		dst = &a.CumulativeReturn
+	case Autocorrelation:
+		dst = &a.Autocorrelation
+	case MaxDrawdown:
+		return json.Unmarshal(value, &a.MaxDrawdown)
+	case Correlation:
+		a.Correlation = new(Matrix)
+		return a.Correlation.unmarshal(value)
+	case Covariance:
+		a.Covariance = new(Matrix)
+		return a.Covariance.unmarshal(value)
+	default:
+		if a.Other == nil {
+			a.Other = make(map[Calculation]json.RawMessage)
+		}
+		a.Other[calculation] = value
+		return nil
+	}
+	return json.Unmarshal(value, dst)
+}
+
+func parseAnalyticsTime(value string) (time.Time, error) {
+	t, err := time.ParseInLocation(api.DefaultDateFormat, value, time.UTC)
+	if err != nil {
+		return time.ParseInLocation(time.DateTime, value, time.UTC)
+	}
+	return t, nil
+}
+
+// Range is a RANGE preset of the analytics functions, such as "full" or
+// "2month". Use DateRange on the query for a range of dates.
+type Range string
+
+// RangeFull requests the full history of the symbols.
+const RangeFull Range = "full"
+
+// LastDays returns the preset for the most recent n days.
+func LastDays(n int) Range { return Range(strconv.Itoa(n) + "day") }
+
+// LastWeeks returns the preset for the most recent n weeks.
+func LastWeeks(n int) Range { return Range(strconv.Itoa(n) + "week") }
+
+// LastMonths returns the preset for the most recent n months.
+func LastMonths(n int) Range { return Range(strconv.Itoa(n) + "month") }
+
+// LastYears returns the preset for the most recent n years.
+func LastYears(n int) Range { return Range(strconv.Itoa(n) + "year") }
+
+func dateRange(from, to time.Time) []string {
+	return []string{from.Format(api.DefaultDateFormat), to.Format(api.DefaultDateFormat)}
+}
+
+// Symbols sets the SYMBOLS parameter.
+func (query AnalyticsFixedWindowQuery) Symbols(symbols ...string) AnalyticsFixedWindowQuery {
+	query["SYMBOLS"] = []string{strings.Join(symbols, ",")}
+	return query
+}
+
+// Range sets the RANGE parameter to a preset.
+func (query AnalyticsFixedWindowQuery) Range(r Range) AnalyticsFixedWindowQuery {
+	query["RANGE"] = []string{string(r)}
+	return query
+}
+
+// DateRange sets the RANGE parameter to the dates from from to to.
+func (query AnalyticsFixedWindowQuery) DateRange(from, to time.Time) AnalyticsFixedWindowQuery {
+	query["RANGE"] = dateRange(from, to)
+	return query
+}
+
+// Symbols sets the SYMBOLS parameter.
+func (query AnalyticsSlidingWindowQuery) Symbols(symbols ...string) AnalyticsSlidingWindowQuery {
+	query["SYMBOLS"] = []string{strings.Join(symbols, ",")}
+	return query
+}
+
+// Range sets the RANGE parameter to a preset.
+func (query AnalyticsSlidingWindowQuery) Range(r Range) AnalyticsSlidingWindowQuery {
+	query["RANGE"] = []string{string(r)}
+	return query
+}
+
+// DateRange sets the RANGE parameter to the dates from from to to.
+func (query AnalyticsSlidingWindowQuery) DateRange(from, to time.Time) AnalyticsSlidingWindowQuery {
+	query["RANGE"] = dateRange(from, to)
+	return query
+}
+
+// WindowSize sets the WINDOW_SIZE parameter, the number of returns in each
+// window.
+func (query AnalyticsSlidingWindowQuery) WindowSize(n int) AnalyticsSlidingWindowQuery {
+	query["WINDOW_SIZE"] = []string{strconv.Itoa(n)}
+	return query
+}
+
+// Calculations sets the CALCULATIONS parameter.
+func (query AnalyticsSlidingWindowQuery) Calculations(calculations ...Calculation) AnalyticsSlidingWindowQuery {
+	query["CALCULATIONS"] = []string{joinCalculations(calculations)}
+	return query
+}
+
+// RollingValue is a statistic of the window of returns from the price on
+// Start to the price on End.
+type RollingValue struct {
+	Start time.Time
+	End   time.Time
+	Value float64
+}
+
+// SlidingWindowAnalytics is the response of ANALYTICS_SLIDING_WINDOW. Each
+// statistic holds one value per window ordered by End. Per symbol statistics
+// are keyed by symbol and pairwise statistics by the pair key of the
+// response. As with FixedWindowAnalytics, a calculation requested with
+// different parameters keeps the last one in Calculations.
+type SlidingWindowAnalytics struct {
+	MetaData     AnalyticsMetaData
+	Calculations []Calculation
+
+	Mean        map[string][]RollingValue
+	StdDev      map[string][]RollingValue
+	Variance    map[string][]RollingValue
+	Correlation map[string][]RollingValue
+	Covariance  map[string][]RollingValue
+
+	// Other holds calculations without a typed field.
+	Other map[Calculation]json.RawMessage
+}
+
+func (a *SlidingWindowAnalytics) UnmarshalJSON(in []byte) error {
+	meta, calculations, values, err := decodeAnalytics(in)
+	if err != nil {
+		return err
+	}
+	result := SlidingWindowAnalytics{MetaData: meta, Calculations: calculations}
+	for _, calculation := range calculations {
+		var dst *map[string][]RollingValue
+		switch calculation.Name() {
+		case Mean:
+			dst = &result.Mean
+		case StdDev:
+			dst = &result.StdDev
+		case Variance:
+			dst = &result.Variance
+		case Correlation:
+			dst = &result.Correlation
+		case Covariance:
+			dst = &result.Covariance
+		default:
+			if result.Other == nil {
+				result.Other = make(map[Calculation]json.RawMessage)
+			}
+			result.Other[calculation] = values[calculation]
+			continue
+		}
+		if *dst, err = decodeRolling(values[calculation]); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", calculation, err)
+		}
+	}
+	*a = result
+	return nil
+}
+
+// decodeRolling decodes the RUNNING_* values of a sliding window calculation
+// and the window_start dates of each window.
+func decodeRolling(in json.RawMessage) (map[string][]RollingValue, error) {
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(in, &data); err != nil {
+		return nil, err
+	}
+	var starts map[string]string
+	if buf, ok := data["window_start"]; ok {
+		if err := json.Unmarshal(buf, &starts); err != nil {
+			return nil, fmt.Errorf("failed to parse window_start: %w", err)
+		}
+	}
+	result := make(map[string][]RollingValue)
+	for key, buf := range data {
+		if !strings.HasPrefix(key, "RUNNING_") {
+			continue
+		}
+		var series map[string]map[string]float64
+		if err := json.Unmarshal(buf, &series); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", key, err)
+		}
+		for name, values := range series {
+			rolling := make([]RollingValue, 0, len(values))
+			for end, value := range values {
+				v := RollingValue{Value: value}
+				var err error
+				if v.End, err = parseAnalyticsTime(end); err != nil {
+					return nil, fmt.Errorf("failed to parse %s date: %w", key, err)
+				}
+				if start, ok := starts[end]; ok {
+					if v.Start, err = parseAnalyticsTime(start); err != nil {
+						return nil, fmt.Errorf("failed to parse window_start: %w", err)
+					}
+				}
+				rolling = append(rolling, v)
+			}
+			slices.SortFunc(rolling, func(a, b RollingValue) int { return a.End.Compare(b.End) })
+			result[name] = rolling
+		}
+	}
+	return result, nil
+}
@@ -43,9 +43,9 @@ func (q AroonQuery) Encode() string {
 }
 
 type AroonRow struct {
-	Time      string `column-name:"time"`
-	AroonDown string `column-name:"Aroon Down"`
-	AroonUp   string `column-name:"Aroon Up"`
+	Time      time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	AroonDown float64   `column-name:"Aroon Down" decimals:"4"`
+	AroonUp   float64   `column-name:"Aroon Up" decimals:"4"`
 }
 
 type AroonOscQuery url.Values
@@ -84,8 +84,8 @@ func (q AroonOscQuery) Encode() string {
 }
 
 type AroonOscRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"AROONOSC"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"AROONOSC" decimals:"4"`
 }
 
 type AverageDirectionalMovementIndexQuery url.Values
@@ -124,8 +124,8 @@ func (q AverageDirectionalMovementIndexQuery) Encode() string {
 }
 
 type AverageDirectionalMovementIndexRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"ADX"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"ADX" decimals:"4"`
 }
 
 type AverageDirectionalMovementIndexRatingQuery url.Values
@@ -164,8 +164,8 @@ func (q AverageDirectionalMovementIndexRatingQuery) Encode() string {
 }
 
 type AverageDirectionalMovementIndexRatingRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"ADXR"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"ADXR" decimals:"4"`
 }
 
 type DirectionalMovementIndexQuery url.Values
@@ -204,8 +204,8 @@ func (q DirectionalMovementIndexQuery) Encode() string {
 }
 
 type DirectionalMovementIndexRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"DX"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"DX" decimals:"4"`
 }
 
 type MinusDirectionalIndicatorQuery url.Values
@@ -244,8 +244,8 @@ func (q MinusDirectionalIndicatorQuery) Encode() string {
 }
 
 type MinusDirectionalIndicatorRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"MINUS_DI"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"MINUS_DI" decimals:"4"`
 }
 
 type MinusDirectionalMovementQuery url.Values
@@ -284,8 +284,8 @@ func (q MinusDirectionalMovementQuery) Encode() string {
 }
 
 type MinusDirectionalMovementRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"MINUS_DM"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"MINUS_DM" decimals:"4"`
 }
 
 type PlusDirectionalIndicatorQuery url.Values
@@ -324,8 +324,8 @@ func (q PlusDirectionalIndicatorQuery) Encode() string {
 }
 
 type PlusDirectionalIndicatorRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"PLUS_DI"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"PLUS_DI" decimals:"4"`
 }
 
 type PlusDirectionalMovementQuery url.Values
@@ -364,6 +364,6 @@ func (q PlusDirectionalMovementQuery) Encode() string {
 }
 
 type PlusDirectionalMovementRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"PLUS_DM"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"PLUS_DM" decimals:"4"`
 }
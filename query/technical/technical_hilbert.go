@@ -38,8 +38,8 @@ func (q HilbertTransformDCPeriodQuery) Encode() string {
 }
 
 type HilbertTransformDCPeriodRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"DCPERIOD"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"DCPERIOD" decimals:"4"`
 }
 
 type HilbertTransformDCPhaseQuery url.Values
@@ -73,8 +73,8 @@ func (q HilbertTransformDCPhaseQuery) Encode() string {
 }
 
 type HilbertTransformDCPhaseRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"HT_DCPHASE"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"HT_DCPHASE" decimals:"4"`
 }
 
 type HilbertTransformPhasorQuery url.Values
@@ -108,9 +108,9 @@ func (q HilbertTransformPhasorQuery) Encode() string {
 }
 
 type HilbertTransformPhasorRow struct {
-	Time       string `column-name:"time"`
-	Phase      string `column-name:"PHASE"`
-	Quadrature string `column-name:"QUADRATURE"`
+	Time       time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Phase      float64   `column-name:"PHASE" decimals:"4"`
+	Quadrature float64   `column-name:"QUADRATURE" decimals:"4"`
 }
 
 type HilbertTransformSineQuery url.Values
@@ -144,9 +144,9 @@ func (q HilbertTransformSineQuery) Encode() string {
 }
 
 type HilbertTransformSineRow struct {
-	Time     string `column-name:"time"`
-	LeadSine string `column-name:"LEAD SINE"`
-	Sine     string `column-name:"SINE"`
+	Time     time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	LeadSine float64   `column-name:"LEAD SINE" decimals:"4"`
+	Sine     float64   `column-name:"SINE" decimals:"4"`
 }
 
 type HilbertTransformTrendLineQuery url.Values
@@ -180,8 +180,8 @@ func (q HilbertTransformTrendLineQuery) Encode() string {
 }
 
 type HilbertTransformTrendLineRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"HT_TRENDLINE"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"HT_TRENDLINE" decimals:"4"`
 }
 
 type HilbertTransformTrendModeQuery url.Values
@@ -215,6 +215,6 @@ func (q HilbertTransformTrendModeQuery) Encode() string {
 }
 
 type HilbertTransformTrendModeRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"TRENDMODE"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"TRENDMODE"`
 }
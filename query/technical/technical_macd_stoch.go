@@ -53,10 +53,10 @@ func (q MovingAverageConvergenceDivergenceQuery) Encode() string {
 }
 
 type MovingAverageConvergenceDivergenceRow struct {
-	Time       string `column-name:"time"`
-	Value      string `column-name:"MACD"`
-	MACDHist   string `column-name:"MACD_Hist"`
-	MACDSignal string `column-name:"MACD_Signal"`
+	Time       time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	MACD       float64   `column-name:"MACD" decimals:"4"`
+	MACDHist   float64   `column-name:"MACD_Hist" decimals:"4"`
+	MACDSignal float64   `column-name:"MACD_Signal" decimals:"4"`
 }
 
 type MovingAverageConvergenceDivergenceExtQuery url.Values
@@ -120,10 +120,10 @@ func (q MovingAverageConvergenceDivergenceExtQuery) Encode() string {
 }
 
 type MovingAverageConvergenceDivergenceExtRow struct {
-	Time                               string `column-name:"time"`
-	MovingAverageConvergenceDivergence string `column-name:"MACD"`
-	MACDHist                           string `column-name:"MACD_Hist"`
-	MACDSignal                         string `column-name:"MACD_Signal"`
+	Time       time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	MACD       float64   `column-name:"MACD" decimals:"4"`
+	MACDHist   float64   `column-name:"MACD_Hist" decimals:"4"`
+	MACDSignal float64   `column-name:"MACD_Signal" decimals:"4"`
 }
 
 type RelativeStrengthIndexQuery url.Values
@@ -162,8 +162,8 @@ func (q RelativeStrengthIndexQuery) Encode() string {
 }
 
 type RelativeStrengthIndexRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"RSI"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"RSI" decimals:"4"`
 }
 
 type StochasticFastQuery url.Values
@@ -212,9 +212,9 @@ func (q StochasticFastQuery) Encode() string {
 }
 
 type StochasticFastRow struct {
-	Time  string `column-name:"time"`
-	FastD string `column-name:"FastD"`
-	FastK string `column-name:"FastK"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	FastD float64   `column-name:"FastD" decimals:"4"`
+	FastK float64   `column-name:"FastK" decimals:"4"`
 }
 
 type StochasticOscillatorQuery url.Values
@@ -273,9 +273,9 @@ func (q StochasticOscillatorQuery) Encode() string {
 }
 
 type StochasticOscillatorRow struct {
-	Time  string `column-name:"time"`
-	SlowD string `column-name:"SlowD"`
-	SlowK string `column-name:"SlowK"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	SlowD float64   `column-name:"SlowD" decimals:"4"`
+	SlowK float64   `column-name:"SlowK" decimals:"4"`
 }
 
 type StochasticRelativeStrengthIndexQuery url.Values
@@ -329,7 +329,7 @@ func (q StochasticRelativeStrengthIndexQuery) Encode() string {
 }
 
 type StochasticRelativeStrengthIndexRow struct {
-	Time  string `column-name:"time"`
-	FastD string `column-name:"FastD"`
-	FastK string `column-name:"FastK"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	FastD float64   `column-name:"FastD" decimals:"4"`
+	FastK float64   `column-name:"FastK" decimals:"4"`
 }
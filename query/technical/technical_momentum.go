@@ -53,8 +53,8 @@ func (q AbsolutePriceOscillatorQuery) Encode() string {
 }
 
 type AbsolutePriceOscillatorRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"APO"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"APO" decimals:"4"`
 }
 
 type BalanceOfPowerQuery url.Values
@@ -88,8 +88,8 @@ func (q BalanceOfPowerQuery) Encode() string {
 }
 
 type BalanceOfPowerRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"BOP"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"BOP" decimals:"4"`
 }
 
 type ChandeMomentumOscillatorQuery url.Values
@@ -128,8 +128,8 @@ func (q ChandeMomentumOscillatorQuery) Encode() string {
 }
 
 type ChandeMomentumOscillatorRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"CMO"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"CMO" decimals:"4"`
 }
 
 type CommodityChannelIndexQuery url.Values
@@ -168,8 +168,8 @@ func (q CommodityChannelIndexQuery) Encode() string {
 }
 
 type CommodityChannelIndexRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"CCI"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"CCI" decimals:"4"`
 }
 
 type MomentumQuery url.Values
@@ -208,8 +208,8 @@ func (q MomentumQuery) Encode() string {
 }
 
 type MomentumRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"MOM"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"MOM" decimals:"4"`
 }
 
 type PercentagePriceOscillatorQuery url.Values
@@ -258,8 +258,8 @@ func (q PercentagePriceOscillatorQuery) Encode() string {
 }
 
 type PercentagePriceOscillatorRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"PPO"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"PPO" decimals:"4"`
 }
 
 type RateOfChangeQuery url.Values
@@ -298,8 +298,8 @@ func (q RateOfChangeQuery) Encode() string {
 }
 
 type RateOfChangeRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"ROC"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"ROC" decimals:"4"`
 }
 
 type RateOfChangeRatioQuery url.Values
@@ -338,8 +338,8 @@ func (q RateOfChangeRatioQuery) Encode() string {
 }
 
 type RateOfChangeRatioRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"ROCR"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"ROCR" decimals:"4"`
 }
 
 type WilliamsRQuery url.Values
@@ -378,6 +378,6 @@ func (q WilliamsRQuery) Encode() string {
 }
 
 type WilliamsRRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"WILLR"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"WILLR" decimals:"4"`
 }
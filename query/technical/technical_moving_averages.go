@@ -43,8 +43,8 @@ func (q DoubleExponentialMovingAverageQuery) Encode() string {
 }
 
 type DoubleExponentialMovingAverageRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"DEMA"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"DEMA" decimals:"4"`
 }
 
 type ExponentialMovingAverageQuery url.Values
@@ -83,8 +83,8 @@ func (q ExponentialMovingAverageQuery) Encode() string {
 }
 
 type ExponentialMovingAverageRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"EMA"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"EMA" decimals:"4"`
 }
 
 type KaufmanAdaptiveMovingAverageQuery url.Values
@@ -123,8 +123,8 @@ func (q KaufmanAdaptiveMovingAverageQuery) Encode() string {
 }
 
 type KaufmanAdaptiveMovingAverageRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"KAMA"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"KAMA" decimals:"4"`
 }
 
 type SimpleMovingAverageQuery url.Values
@@ -163,8 +163,8 @@ func (q SimpleMovingAverageQuery) Encode() string {
 }
 
 type SimpleMovingAverageRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"SMA"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"SMA" decimals:"4"`
 }
 
 type T3Query url.Values
@@ -203,8 +203,8 @@ func (q T3Query) Encode() string {
 }
 
 type T3Row struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"T3"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"T3" decimals:"4"`
 }
 
 type TriangularMovingAverageQuery url.Values
@@ -243,8 +243,8 @@ func (q TriangularMovingAverageQuery) Encode() string {
 }
 
 type TriangularMovingAverageRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"TRIMA"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"TRIMA" decimals:"4"`
 }
 
 type TripleExponentialMovingAverageQuery url.Values
@@ -283,8 +283,8 @@ func (q TripleExponentialMovingAverageQuery) Encode() string {
 }
 
 type TripleExponentialMovingAverageRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"TEMA"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"TEMA" decimals:"4"`
 }
 
 type WeightedMovingAverageQuery url.Values
@@ -323,6 +323,6 @@ func (q WeightedMovingAverageQuery) Encode() string {
 }
 
 type WeightedMovingAverageRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"WMA"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"WMA" decimals:"4"`
 }
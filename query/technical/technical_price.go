@@ -38,8 +38,8 @@ func (q MidPointQuery) Encode() string {
 }
 
 type MidPointRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"MIDPOINT"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"MIDPOINT" decimals:"4"`
 }
 
 type MidPriceQuery url.Values
@@ -73,6 +73,6 @@ func (q MidPriceQuery) Encode() string {
 }
 
 type MidPriceRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"MIDPRICE"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"MIDPRICE" decimals:"4"`
 }
@@ -48,9 +48,9 @@ func (q MESAAdaptiveMovingAverageQuery) Encode() string {
 }
 
 type MESAAdaptiveMovingAverageRow struct {
-	Time  string `column-name:"time"`
-	FAMA  string `column-name:"FAMA"`
-	Value string `column-name:"MAMA"`
+	Time time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	FAMA float64   `column-name:"FAMA" decimals:"4"`
+	MAMA float64   `column-name:"MAMA" decimals:"4"`
 }
 
 type VolumeWeightedAveragePriceQuery url.Values
@@ -84,6 +84,6 @@ func (q VolumeWeightedAveragePriceQuery) Encode() string {
 }
 
 type VolumeWeightedAveragePriceRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"VWAP"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"VWAP" decimals:"4"`
 }
@@ -43,8 +43,8 @@ func (q AverageTrueRangeQuery) Encode() string {
 }
 
 type AverageTrueRangeRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"ATR"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"ATR" decimals:"4"`
 }
 
 type BollingerBandsQuery url.Values
@@ -98,10 +98,10 @@ func (q BollingerBandsQuery) Encode() string {
 }
 
 type BollingerBandsRow struct {
-	Time           string `column-name:"time"`
-	RealLowerBand  string `column-name:"Real Lower Band"`
-	RealMiddleBand string `column-name:"Real Middle Band"`
-	RealUpperBand  string `column-name:"Real Upper Band"`
+	Time       time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	LowerBand  float64   `column-name:"Real Lower Band" decimals:"4"`
+	MiddleBand float64   `column-name:"Real Middle Band" decimals:"4"`
+	UpperBand  float64   `column-name:"Real Upper Band" decimals:"4"`
 }
 
 type NormalizedAverageTrueRangeQuery url.Values
@@ -140,8 +140,8 @@ func (q NormalizedAverageTrueRangeQuery) Encode() string {
 }
 
 type NormalizedAverageTrueRangeRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"NATR"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"NATR" decimals:"4"`
 }
 
 type SARQuery url.Values
@@ -185,8 +185,8 @@ func (q SARQuery) Encode() string {
 }
 
 type SARRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"SAR"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"SAR" decimals:"4"`
 }
 
 type TrueRangeQuery url.Values
@@ -220,8 +220,8 @@ func (q TrueRangeQuery) Encode() string {
 }
 
 type TrueRangeRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"TRANGE"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"TRANGE" decimals:"4"`
 }
 
 type UltimateOscillatorQuery url.Values
@@ -270,6 +270,6 @@ func (q UltimateOscillatorQuery) Encode() string {
 }
 
 type UltimateOscillatorRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"ULTOSC"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"ULTOSC" decimals:"4"`
 }
@@ -38,8 +38,8 @@ func (q ChaikinADLineQuery) Encode() string {
 }
 
 type ChaikinADLineRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"Chaikin A/D"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"Chaikin A/D" decimals:"4"`
 }
 
 type ChaikinADOscillatorQuery url.Values
@@ -83,8 +83,8 @@ func (q ChaikinADOscillatorQuery) Encode() string {
 }
 
 type ChaikinADOscillatorRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"ADOSC"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"ADOSC" decimals:"4"`
 }
 
 type MoneyFlowIndexQuery url.Values
@@ -123,8 +123,8 @@ func (q MoneyFlowIndexQuery) Encode() string {
 }
 
 type MoneyFlowIndexRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"MFI"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"MFI" decimals:"4"`
 }
 
 type OnBalanceVolumeQuery url.Values
@@ -158,8 +158,8 @@ func (q OnBalanceVolumeQuery) Encode() string {
 }
 
 type OnBalanceVolumeRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"OBV"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"OBV" decimals:"4"`
 }
 
 type OneDayRateOfChangeTripleSmoothExponentialMovingAverageQuery url.Values
@@ -198,6 +198,6 @@ func (q OneDayRateOfChangeTripleSmoothExponentialMovingAverageQuery) Encode() st
 }
 
 type OneDayRateOfChangeTripleSmoothExponentialMovingAverageRow struct {
-	Time  string `column-name:"time"`
-	Value string `column-name:"TRIX"`
+	Time  time.Time `column-name:"time" time-layout:"2006-01-02 15:04:05|2006-01-02 15:04|2006-01-02"`
+	Value float64   `column-name:"TRIX" decimals:"4"`
 }
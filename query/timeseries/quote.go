@@ -0,0 +1,23 @@
+package timeseries
+
+// RealtimeBulkQuotesMaxSymbols is the number of symbols AlphaVantage accepts
+// in a single REALTIME_BULK_QUOTES request.
+const RealtimeBulkQuotesMaxSymbols = 100
+
+// RealtimeBulkQuote converts a GLOBAL_QUOTE row to the REALTIME_BULK_QUOTES row shape.
+// The latest trading day is used as the timestamp and the price as the close.
+// Extended hours fields are left zero.
+func (row GlobalQuoteRow) RealtimeBulkQuote() RealtimeBulkQuotesRow {
+	return RealtimeBulkQuotesRow{
+		Symbol:        row.Symbol,
+		TimeStamp:     row.LatestDay,
+		Open:          row.Open,
+		High:          row.High,
+		Low:           row.Low,
+		Close:         row.Price,
+		Volume:        row.Volume,
+		PreviousClose: row.PreviousClose,
+		Change:        row.Change,
+		ChangePercent: row.ChangePercent,
+	}
+}
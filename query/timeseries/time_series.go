@@ -3,6 +3,7 @@
 package timeseries
 
 import (
+	"github.com/portfoliotree/alphavantage/api"
 	"net/url"
 	"strconv"
 	"time"
@@ -34,16 +35,16 @@ func (q GlobalQuoteQuery) Encode() string {
 }
 
 type GlobalQuoteRow struct {
-	Symbol        string `column-name:"symbol"`
-	Open          string `column-name:"open"`
-	High          string `column-name:"high"`
-	Low           string `column-name:"low"`
-	Price         string `column-name:"price"`
-	Volume        string `column-name:"volume"`
-	LatestDay     string `column-name:"latestDay"`
-	PreviousClose string `column-name:"previousClose"`
-	Change        string `column-name:"change"`
-	ChangePercent string `column-name:"changePercent"`
+	Symbol        string      `column-name:"symbol"`
+	Open          float64     `column-name:"open" decimals:"4"`
+	High          float64     `column-name:"high" decimals:"4"`
+	Low           float64     `column-name:"low" decimals:"4"`
+	Price         float64     `column-name:"price" decimals:"4"`
+	Volume        int         `column-name:"volume"`
+	LatestDay     time.Time   `column-name:"latestDay" time-layout:"2006-01-02"`
+	PreviousClose float64     `column-name:"previousClose" decimals:"4"`
+	Change        float64     `column-name:"change" decimals:"4"`
+	ChangePercent api.Percent `column-name:"changePercent" suffix:"%" decimals:"4"`
 }
 
 type MarketStatusQuery url.Values
@@ -81,6 +82,22 @@ func (q RealtimeBulkQuotesQuery) Encode() string {
 	return url.Values(q).Encode()
 }
 
+type RealtimeBulkQuotesRow struct {
+	Symbol                     string      `column-name:"symbol"`
+	TimeStamp                  time.Time   `column-name:"timestamp" time-layout:"2006-01-02 15:04:05.999"`
+	Open                       float64     `column-name:"open"`
+	High                       float64     `column-name:"high"`
+	Low                        float64     `column-name:"low"`
+	Close                      float64     `column-name:"close"`
+	Volume                     int         `column-name:"volume"`
+	PreviousClose              float64     `column-name:"previous_close"`
+	Change                     float64     `column-name:"change"`
+	ChangePercent              api.Percent `column-name:"change_percent"`
+	ExtendedHoursQuote         float64     `column-name:"extended_hours_quote"`
+	ExtendedHoursChange        float64     `column-name:"extended_hours_change"`
+	ExtendedHoursChangePercent api.Percent `column-name:"extended_hours_change_percent"`
+}
+
 type SymbolSearchQuery url.Values
 
 func QuerySymbolSearch(apiKey, keywords string) SymbolSearchQuery {
@@ -115,7 +132,7 @@ type SymbolSearchRow struct {
 	MarketClose string  `column-name:"marketClose"`
 	TimeZone    string  `column-name:"timezone"`
 	Currency    string  `column-name:"currency"`
-	MatchScore  float64 `column-name:"matchScore"`
+	MatchScore  float64 `column-name:"matchScore" decimals:"4"`
 }
 
 type DailyQuery url.Values
@@ -160,10 +177,10 @@ func (q DailyQuery) Encode() string {
 
 type DailyRow struct {
 	TimeStamp time.Time `column-name:"timestamp" time-layout:"2006-01-02"`
-	Open      float64   `column-name:"open"`
-	High      float64   `column-name:"high"`
-	Low       float64   `column-name:"low"`
-	Close     float64   `column-name:"close"`
+	Open      float64   `column-name:"open" decimals:"4"`
+	High      float64   `column-name:"high" decimals:"4"`
+	Low       float64   `column-name:"low" decimals:"4"`
+	Close     float64   `column-name:"close" decimals:"4"`
 	Volume    int       `column-name:"volume"`
 }
 
@@ -209,14 +226,14 @@ func (q DailyAdjustedQuery) Encode() string {
 
 type DailyAdjustedRow struct {
 	TimeStamp        time.Time `column-name:"timestamp" time-layout:"2006-01-02"`
-	Open             float64   `column-name:"open"`
-	High             float64   `column-name:"high"`
-	Low              float64   `column-name:"low"`
-	Close            float64   `column-name:"close"`
-	AdjustedClose    float64   `column-name:"adjusted_close"`
+	Open             float64   `column-name:"open" decimals:"1"`
+	High             float64   `column-name:"high" decimals:"1"`
+	Low              float64   `column-name:"low" decimals:"1"`
+	Close            float64   `column-name:"close" decimals:"1"`
+	AdjustedClose    float64   `column-name:"adjusted_close" decimals:"1"`
 	Volume           int       `column-name:"volume"`
-	DividendAmount   float64   `column-name:"dividend_amount"`
-	SplitCoefficient float64   `column-name:"split_coefficient"`
+	DividendAmount   float64   `column-name:"dividend_amount" decimals:"4"`
+	SplitCoefficient float64   `column-name:"split_coefficient" decimals:"1"`
 }
 
 type IntradayQuery url.Values
@@ -276,10 +293,10 @@ func (q IntradayQuery) Encode() string {
 
 type IntradayRow struct {
 	TimeStamp time.Time `column-name:"timestamp" time-layout:"2006-01-02 15:04:05"`
-	Open      float64   `column-name:"open"`
-	High      float64   `column-name:"high"`
-	Low       float64   `column-name:"low"`
-	Close     float64   `column-name:"close"`
+	Open      float64   `column-name:"open" decimals:"4"`
+	High      float64   `column-name:"high" decimals:"4"`
+	Low       float64   `column-name:"low" decimals:"4"`
+	Close     float64   `column-name:"close" decimals:"4"`
 	Volume    int       `column-name:"volume"`
 }
 
@@ -310,10 +327,10 @@ func (q MonthlyQuery) Encode() string {
 
 type MonthlyRow struct {
 	TimeStamp time.Time `column-name:"timestamp" time-layout:"2006-01-02"`
-	Open      float64   `column-name:"open"`
-	High      float64   `column-name:"high"`
-	Low       float64   `column-name:"low"`
-	Close     float64   `column-name:"close"`
+	Open      float64   `column-name:"open" decimals:"4"`
+	High      float64   `column-name:"high" decimals:"4"`
+	Low       float64   `column-name:"low" decimals:"4"`
+	Close     float64   `column-name:"close" decimals:"4"`
 	Volume    int       `column-name:"volume"`
 }
 
@@ -344,13 +361,13 @@ func (q MonthlyAdjustedQuery) Encode() string {
 
 type MonthlyAdjustedRow struct {
 	TimeStamp      time.Time `column-name:"timestamp" time-layout:"2006-01-02"`
-	Open           float64   `column-name:"open"`
-	High           float64   `column-name:"high"`
-	Low            float64   `column-name:"low"`
-	Close          float64   `column-name:"close"`
-	AdjustedClose  float64   `column-name:"adjusted close"`
+	Open           float64   `column-name:"open" decimals:"4"`
+	High           float64   `column-name:"high" decimals:"4"`
+	Low            float64   `column-name:"low" decimals:"4"`
+	Close          float64   `column-name:"close" decimals:"4"`
+	AdjustedClose  float64   `column-name:"adjusted close" decimals:"4"`
 	Volume         int       `column-name:"volume"`
-	DividendAmount float64   `column-name:"dividend amount"`
+	DividendAmount float64   `column-name:"dividend amount" decimals:"4"`
 }
 
 type WeeklyQuery url.Values
@@ -380,10 +397,10 @@ func (q WeeklyQuery) Encode() string {
 
 type WeeklyRow struct {
 	TimeStamp time.Time `column-name:"timestamp" time-layout:"2006-01-02"`
-	Open      float64   `column-name:"open"`
-	High      float64   `column-name:"high"`
-	Low       float64   `column-name:"low"`
-	Close     float64   `column-name:"close"`
+	Open      float64   `column-name:"open" decimals:"4"`
+	High      float64   `column-name:"high" decimals:"4"`
+	Low       float64   `column-name:"low" decimals:"4"`
+	Close     float64   `column-name:"close" decimals:"4"`
 	Volume    int       `column-name:"volume"`
 }
 
@@ -414,11 +431,11 @@ func (q WeeklyAdjustedQuery) Encode() string {
 
 type WeeklyAdjustedRow struct {
 	TimeStamp      time.Time `column-name:"timestamp" time-layout:"2006-01-02"`
-	Open           float64   `column-name:"open"`
-	High           float64   `column-name:"high"`
-	Low            float64   `column-name:"low"`
-	Close          float64   `column-name:"close"`
-	AdjustedClose  float64   `column-name:"adjusted close"`
+	Open           float64   `column-name:"open" decimals:"4"`
+	High           float64   `column-name:"high" decimals:"4"`
+	Low            float64   `column-name:"low" decimals:"4"`
+	Close          float64   `column-name:"close" decimals:"4"`
+	AdjustedClose  float64   `column-name:"adjusted close" decimals:"4"`
 	Volume         int       `column-name:"volume"`
-	DividendAmount float64   `column-name:"dividend amount"`
+	DividendAmount float64   `column-name:"dividend amount" decimals:"4"`
 }
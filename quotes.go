@@ -0,0 +1,47 @@
+package alphavantage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/portfoliotree/alphavantage/api"
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+)
+
+// Quotes fetches the latest quote for each symbol. Symbols are requested in
+// REALTIME_BULK_QUOTES batches of up to timeseries.RealtimeBulkQuotesMaxSymbols.
+// When the plan does not include bulk quotes (the API responds with
+// api.ErrPremiumEndpoint) the remaining symbols are fetched one at a time
+// with GLOBAL_QUOTE.
+func (f *TimeSeriesFunctions) Quotes(ctx context.Context, symbols ...string) ([]timeseries.RealtimeBulkQuotesRow, error) {
+	result := make([]timeseries.RealtimeBulkQuotesRow, 0, len(symbols))
+	fetched := 0
+	for batch := range slices.Chunk(symbols, timeseries.RealtimeBulkQuotesMaxSymbols) {
+		rows, err := f.RealtimeBulkQuotes(ctx, timeseries.QueryRealtimeBulkQuotes(f.APIKey, strings.Join(batch, ",")).DataTypeCSV())
+		if err != nil {
+			if errors.Is(err, api.ErrPremiumEndpoint) {
+				return f.globalQuotes(ctx, result, symbols[fetched:])
+			}
+			return nil, err
+		}
+		result = append(result, rows...)
+		fetched += len(batch)
+	}
+	return result, nil
+}
+
+func (f *TimeSeriesFunctions) globalQuotes(ctx context.Context, result []timeseries.RealtimeBulkQuotesRow, symbols []string) ([]timeseries.RealtimeBulkQuotesRow, error) {
+	for _, symbol := range symbols {
+		rows, err := f.GlobalQuote(ctx, timeseries.QueryGlobalQuote(f.APIKey, symbol).DataTypeCSV())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get quote for %s: %w", symbol, err)
+		}
+		for _, row := range rows {
+			result = append(result, row.RealtimeBulkQuote())
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,319 @@
+// Package resample aggregates time series bars into longer periods: N-minute,
+// daily, weekly, monthly, quarterly and yearly bars. The aggregated bars use
+// the first open, the highest high, the lowest low, the last close and the
+// summed volume of the bars in each period, like TIME_SERIES_WEEKLY and
+// TIME_SERIES_MONTHLY.
+//
+// Periods follow the wall clock of the bar timestamps. AlphaVantage writes
+// equity timestamps in US/Eastern time, so rows parsed with any location keep
+// their Eastern calendar days and daylight saving changes do not move the
+// period boundaries. Set Resampler.Location to convert timestamps first, for
+// example to the location api.Eastern returns when rows were parsed as
+// instants in another time zone.
+package resample
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+)
+
+// Bar is a single OHLCV observation.
+type Bar struct {
+	TimeStamp time.Time
+	Open      float64
+	High      float64
+	Low       float64
+	Close     float64
+	Volume    int
+}
+
+// Period is an aggregated bar.
+type Period struct {
+	// Start and End bound the period: [Start, End).
+	Start, End time.Time
+	// TimeStamp labels the bar the way AlphaVantage does: the start of the
+	// period for N-minute bars and the day of the last bar in the period for
+	// daily and longer bars.
+	TimeStamp time.Time
+	Open      float64
+	High      float64
+	Low       float64
+	Close     float64
+	Volume    int
+	// Count is the number of bars aggregated.
+	Count int
+	// Partial is set on the first or last period when the bars do not
+	// cover all of its trading time, such as the current week.
+	Partial bool
+}
+
+// Bar returns the period as a Bar labeled with TimeStamp, so it can be
+// resampled again.
+func (p Period) Bar() Bar {
+	return Bar{TimeStamp: p.TimeStamp, Open: p.Open, High: p.High, Low: p.Low, Close: p.Close, Volume: p.Volume}
+}
+
+type unit int
+
+const (
+	minute unit = iota
+	day
+	week
+	month
+	quarter
+	year
+)
+
+// Rule selects the length of the aggregated periods.
+type Rule struct {
+	unit    unit
+	minutes int
+	weekEnd time.Weekday
+}
+
+// Minutes aggregates into n minute periods counted from midnight. Values of
+// n below 1 are treated as 1.
+func Minutes(n int) Rule { return Rule{unit: minute, minutes: max(n, 1)} }
+
+// Daily aggregates into calendar days.
+func Daily() Rule { return Rule{unit: day} }
+
+// Weekly aggregates into the seven days ending on end. TIME_SERIES_WEEKLY
+// weeks end on time.Friday.
+func Weekly(end time.Weekday) Rule { return Rule{unit: week, weekEnd: end} }
+
+// Monthly aggregates into calendar months.
+func Monthly() Rule { return Rule{unit: month} }
+
+// Quarterly aggregates into calendar quarters.
+func Quarterly() Rule { return Rule{unit: quarter} }
+
+// Yearly aggregates into calendar years.
+func Yearly() Rule { return Rule{unit: year} }
+
+// period returns the bounds of the period containing t.
+func (r Rule) period(t time.Time) (start, end time.Time) {
+	y, m, d := t.Date()
+	loc := t.Location()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	switch r.unit {
+	case minute:
+		n := (t.Hour()*60 + t.Minute()) / r.minutes * r.minutes
+		start = time.Date(y, m, d, 0, n, 0, 0, loc)
+		end = time.Date(y, m, d, 0, n+r.minutes, 0, 0, loc)
+		if next := midnight.AddDate(0, 0, 1); end.After(next) {
+			end = next
+		}
+		return start, end
+	case week:
+		daysSinceStart := (int(t.Weekday()) - int(r.weekEnd) + 6) % 7
+		start = midnight.AddDate(0, 0, -daysSinceStart)
+		return start, start.AddDate(0, 0, 7)
+	case month:
+		start = time.Date(y, m, 1, 0, 0, 0, 0, loc)
+		return start, start.AddDate(0, 1, 0)
+	case quarter:
+		start = time.Date(y, (m-1)/3*3+1, 1, 0, 0, 0, 0, loc)
+		return start, start.AddDate(0, 3, 0)
+	case year:
+		start = time.Date(y, 1, 1, 0, 0, 0, 0, loc)
+		return start, start.AddDate(1, 0, 0)
+	}
+	return midnight, midnight.AddDate(0, 0, 1)
+}
+
+// Resampler aggregates bars with a Rule.
+type Resampler struct {
+	Rule Rule
+	// Location, when set, converts the timestamps before they are
+	// assigned to periods.
+	Location *time.Location
+	// SessionOpen and SessionClose are the trading hours, as time since
+	// midnight, used to decide whether intraday bars cover a whole day. They
+	// default to AlphaVantage's extended hours, 4:00 to 20:00.
+	SessionOpen, SessionClose time.Duration
+}
+
+// Resample aggregates bars with rule and the default Resampler settings.
+func Resample(bars []Bar, rule Rule) []Period {
+	return Resampler{Rule: rule}.Resample(bars)
+}
+
+// Resample aggregates bars in any order into periods ordered from the oldest
+// to the most recent.
+//
+// Partial periods are detected from the interval between the input bars and
+// the trading days Monday to Friday; market holidays are not known, so a
+// period that ends on a holiday is reported as partial.
+func (r Resampler) Resample(bars []Bar) []Period {
+	bars = slices.Clone(bars)
+	if r.Location != nil {
+		for i := range bars {
+			bars[i].TimeStamp = bars[i].TimeStamp.In(r.Location)
+		}
+	}
+	slices.SortStableFunc(bars, func(a, b Bar) int { return a.TimeStamp.Compare(b.TimeStamp) })
+
+	var periods []Period
+	for _, bar := range bars {
+		if n := len(periods); n > 0 && bar.TimeStamp.Before(periods[n-1].End) {
+			p := &periods[n-1]
+			p.High = max(p.High, bar.High)
+			p.Low = min(p.Low, bar.Low)
+			p.Close = bar.Close
+			p.Volume += bar.Volume
+			p.Count++
+			if r.Rule.unit != minute {
+				p.TimeStamp = startOfDay(bar.TimeStamp)
+			}
+			continue
+		}
+		start, end := r.Rule.period(bar.TimeStamp)
+		p := Period{Start: start, End: end, TimeStamp: start, Open: bar.Open, High: bar.High, Low: bar.Low, Close: bar.Close, Volume: bar.Volume, Count: 1}
+		if r.Rule.unit != minute {
+			p.TimeStamp = startOfDay(bar.TimeStamp)
+		}
+		periods = append(periods, p)
+	}
+	if len(periods) == 0 {
+		return periods
+	}
+
+	step := interval(bars)
+	intraday := step < 24*time.Hour
+	first, last := bars[0].TimeStamp, bars[len(bars)-1].TimeStamp
+	// weekly and monthly rows are labeled with the last day they cover
+	switch {
+	case step >= 28*24*time.Hour:
+		first = time.Date(first.Year(), first.Month(), 1, 0, 0, 0, 0, first.Location())
+	case step >= 7*24*time.Hour:
+		first = startOfDay(first).AddDate(0, 0, -6)
+	}
+	if first.After(r.tradingStart(periods[0], intraday)) {
+		periods[0].Partial = true
+	}
+	dataEnd := last.Add(step)
+	if !intraday {
+		dataEnd = startOfDay(last).AddDate(0, 0, 1)
+	}
+	if dataEnd.Before(r.tradingEnd(periods[len(periods)-1], intraday)) {
+		periods[len(periods)-1].Partial = true
+	}
+	return periods
+}
+
+// tradingStart is the earliest time a bar in p may have.
+func (r Resampler) tradingStart(p Period, intraday bool) time.Time {
+	if r.Rule.unit == minute {
+		return p.Start
+	}
+	d := p.Start
+	for isWeekend(d) && d.Before(p.End) {
+		d = d.AddDate(0, 0, 1)
+	}
+	if !d.Before(p.End) {
+		d = p.Start
+	}
+	if intraday {
+		return clock(d, cmp.Or(r.SessionOpen, 4*time.Hour))
+	}
+	return d
+}
+
+// tradingEnd is the time the bars covering p must reach.
+func (r Resampler) tradingEnd(p Period, intraday bool) time.Time {
+	if r.Rule.unit == minute {
+		return p.End
+	}
+	d := p.End.AddDate(0, 0, -1)
+	for isWeekend(d) && !d.Before(p.Start) {
+		d = d.AddDate(0, 0, -1)
+	}
+	if d.Before(p.Start) {
+		d = p.End.AddDate(0, 0, -1)
+	}
+	if intraday {
+		return clock(d, cmp.Or(r.SessionClose, 20*time.Hour))
+	}
+	return d.AddDate(0, 0, 1)
+}
+
+// interval is the median time between consecutive bars, which skips the
+// overnight and weekend gaps and the short last period of weekly and monthly
+// rows. A single bar is taken as daily when it is at midnight and as one
+// minute otherwise.
+func interval(bars []Bar) time.Duration {
+	var gaps []time.Duration
+	for i := 1; i < len(bars); i++ {
+		if d := bars[i].TimeStamp.Sub(bars[i-1].TimeStamp); d > 0 {
+			gaps = append(gaps, d)
+		}
+	}
+	if len(gaps) == 0 {
+		if t := bars[0].TimeStamp; t.Equal(startOfDay(t)) {
+			return 24 * time.Hour
+		}
+		return time.Minute
+	}
+	slices.Sort(gaps)
+	step := gaps[len(gaps)/2]
+	// daily bars are 23 or 25 hours apart around daylight saving changes
+	if step > 22*time.Hour && step < 24*time.Hour {
+		return 24 * time.Hour
+	}
+	return step
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// clock returns the wall clock time offset after midnight of day d.
+func clock(d time.Time, offset time.Duration) time.Time {
+	y, m, day := d.Date()
+	return time.Date(y, m, day, 0, int(offset/time.Minute), 0, 0, d.Location())
+}
+
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
+
+// FromIntradayRows converts TIME_SERIES_INTRADAY rows.
+func FromIntradayRows(rows []timeseries.IntradayRow) []Bar {
+	return fromRows(rows, func(r timeseries.IntradayRow) Bar {
+		return Bar{TimeStamp: r.TimeStamp, Open: r.Open, High: r.High, Low: r.Low, Close: r.Close, Volume: r.Volume}
+	})
+}
+
+// FromDailyRows converts TIME_SERIES_DAILY rows.
+func FromDailyRows(rows []timeseries.DailyRow) []Bar {
+	return fromRows(rows, func(r timeseries.DailyRow) Bar {
+		return Bar{TimeStamp: r.TimeStamp, Open: r.Open, High: r.High, Low: r.Low, Close: r.Close, Volume: r.Volume}
+	})
+}
+
+// FromWeeklyRows converts TIME_SERIES_WEEKLY rows.
+func FromWeeklyRows(rows []timeseries.WeeklyRow) []Bar {
+	return fromRows(rows, func(r timeseries.WeeklyRow) Bar {
+		return Bar{TimeStamp: r.TimeStamp, Open: r.Open, High: r.High, Low: r.Low, Close: r.Close, Volume: r.Volume}
+	})
+}
+
+// FromMonthlyRows converts TIME_SERIES_MONTHLY rows.
+func FromMonthlyRows(rows []timeseries.MonthlyRow) []Bar {
+	return fromRows(rows, func(r timeseries.MonthlyRow) Bar {
+		return Bar{TimeStamp: r.TimeStamp, Open: r.Open, High: r.High, Low: r.Low, Close: r.Close, Volume: r.Volume}
+	})
+}
+
+func fromRows[T any](rows []T, convert func(T) Bar) []Bar {
+	bars := make([]Bar, len(rows))
+	for i, row := range rows {
+		bars[i] = convert(row)
+	}
+	return bars
+}
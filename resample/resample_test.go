@@ -0,0 +1,139 @@
+package resample_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/portfoliotree/alphavantage/api"
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+	"github.com/portfoliotree/alphavantage/resample"
+)
+
+const examplesDir = "../specification/testdata/examples/time_series"
+
+func TestResample_weekly(t *testing.T) {
+	daily := resample.FromDailyRows(load[timeseries.DailyRow](t, "TIME_SERIES_DAILY_42a08190.csv"))
+	weekly := load[timeseries.WeeklyRow](t, "TIME_SERIES_WEEKLY_74eb54d6.csv")
+
+	periods := resample.Resample(daily, resample.Weekly(time.Friday))
+	require.NotEmpty(t, periods)
+	for i, p := range periods {
+		// weekly rows are newest first
+		want := weekly[len(periods)-1-i]
+		assert.Equal(t, want.TimeStamp, p.TimeStamp)
+		assert.InDelta(t, want.Open, p.Open, 1e-9, "open %s", p.TimeStamp)
+		assert.InDelta(t, want.High, p.High, 1e-9, "high %s", p.TimeStamp)
+		assert.InDelta(t, want.Low, p.Low, 1e-9, "low %s", p.TimeStamp)
+		assert.InDelta(t, want.Close, p.Close, 1e-9, "close %s", p.TimeStamp)
+		assert.Equal(t, want.Volume, p.Volume, "volume %s", p.TimeStamp)
+		assert.False(t, p.Partial, p.TimeStamp)
+		assert.Equal(t, time.Saturday, p.Start.Weekday())
+	}
+	assert.Equal(t, time.Date(2025, 12, 20, 0, 0, 0, 0, time.UTC), periods[0].Start)
+
+	sundays := resample.Resample(daily, resample.Weekly(time.Sunday))
+	assert.Equal(t, time.Monday, sundays[0].Start.Weekday())
+	assert.Equal(t, len(periods), len(sundays))
+}
+
+func TestResample_monthly(t *testing.T) {
+	daily := resample.FromDailyRows(load[timeseries.DailyRow](t, "TIME_SERIES_DAILY_42a08190.csv"))
+	monthly := load[timeseries.MonthlyRow](t, "TIME_SERIES_MONTHLY_3ced87e9.csv")
+
+	periods := resample.Resample(daily, resample.Monthly())
+	require.Len(t, periods, 6)
+	assert.True(t, periods[0].Partial, "December 2025 starts on the 22nd")
+	assert.True(t, periods[5].Partial, "May 2026 ends on the 15th")
+	for i, p := range periods[1:] {
+		want := monthly[len(periods)-2-i]
+		assert.Equal(t, want.TimeStamp, p.TimeStamp)
+		assert.InDelta(t, want.Open, p.Open, 1e-9)
+		assert.InDelta(t, want.High, p.High, 1e-9)
+		assert.InDelta(t, want.Low, p.Low, 1e-9)
+		assert.InDelta(t, want.Close, p.Close, 1e-9)
+		assert.Equal(t, want.Volume, p.Volume)
+		assert.Equal(t, i < 4, !p.Partial, p.TimeStamp)
+	}
+
+	months := resample.FromMonthlyRows(monthly)
+	quarters := resample.Resample(months, resample.Quarterly())
+	years := resample.Resample(months, resample.Yearly())
+	q1 := quarters[len(quarters)-2]
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), q1.Start)
+	assert.Equal(t, time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC), q1.TimeStamp)
+	assert.Equal(t, 3, q1.Count)
+	assert.False(t, q1.Partial)
+	assert.True(t, quarters[len(quarters)-1].Partial)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), years[len(years)-1].Start)
+	assert.Equal(t, 5, years[len(years)-1].Count)
+	assert.True(t, years[len(years)-1].Partial)
+	assert.False(t, years[len(years)-2].Partial)
+}
+
+func TestResample_intraday(t *testing.T) {
+	rows := load[timeseries.IntradayRow](t, "TIME_SERIES_INTRADAY_3dbdcdc7.csv")
+	bars := resample.FromIntradayRows(rows)
+
+	periods := resample.Resample(bars, resample.Minutes(15))
+	first, last := periods[0], periods[len(periods)-1]
+	assert.Equal(t, time.Date(2026, 5, 15, 11, 30, 0, 0, time.UTC), first.TimeStamp)
+	assert.True(t, first.Partial, "the first bar is at 11:40")
+	assert.Equal(t, 1, first.Count)
+	assert.Equal(t, time.Date(2026, 5, 15, 19, 45, 0, 0, time.UTC), last.TimeStamp)
+	assert.False(t, last.Partial)
+	// rows are newest first: 19:55, 19:50, 19:45
+	assert.Equal(t, rows[2].Open, last.Open)
+	assert.Equal(t, rows[0].Close, last.Close)
+	assert.Equal(t, max(rows[0].High, rows[1].High, rows[2].High), last.High)
+	assert.Equal(t, min(rows[0].Low, rows[1].Low, rows[2].Low), last.Low)
+	assert.Equal(t, rows[0].Volume+rows[1].Volume+rows[2].Volume, last.Volume)
+
+	days := resample.Resample(bars, resample.Daily())
+	require.Len(t, days, 1)
+	assert.Equal(t, time.Date(2026, 5, 15, 0, 0, 0, 0, time.UTC), days[0].TimeStamp)
+	assert.Equal(t, len(rows), days[0].Count)
+	assert.True(t, days[0].Partial)
+
+	days = resample.Resampler{Rule: resample.Daily(), SessionOpen: 11*time.Hour + 40*time.Minute}.Resample(bars)
+	assert.False(t, days[0].Partial, "the session ends at 20:00 after the last bar")
+}
+
+func TestResample_eastern(t *testing.T) {
+	eastern, err := api.Eastern()
+	require.NoError(t, err)
+	// the Sunday 2026-03-08 daylight saving change makes the day 23 hours long
+	day := time.Date(2026, 3, 8, 0, 0, 0, 0, eastern)
+	bars := []resample.Bar{
+		{TimeStamp: time.Date(2026, 3, 7, 23, 30, 0, 0, eastern), Open: 1, High: 1, Low: 1, Close: 1, Volume: 1},
+		{TimeStamp: time.Date(2026, 3, 8, 9, 30, 0, 0, eastern), Open: 2, High: 2, Low: 2, Close: 2, Volume: 1},
+		{TimeStamp: time.Date(2026, 3, 8, 10, 45, 0, 0, eastern), Open: 3, High: 3, Low: 3, Close: 3, Volume: 1},
+	}
+	days := resample.Resample(bars, resample.Daily())
+	require.Len(t, days, 2)
+	assert.Equal(t, day, days[1].Start)
+	assert.Equal(t, 23*time.Hour, days[1].End.Sub(days[1].Start))
+
+	hours := resample.Resample(bars, resample.Minutes(60))
+	require.Len(t, hours, 3)
+	assert.Equal(t, time.Date(2026, 3, 8, 9, 0, 0, 0, eastern), hours[1].Start)
+
+	// 02:00 UTC on the 15th is the evening of the 14th in New York
+	utc := []resample.Bar{{TimeStamp: time.Date(2026, 5, 15, 2, 0, 0, 0, time.UTC), Close: 1}}
+	days = resample.Resampler{Rule: resample.Daily(), Location: eastern}.Resample(utc)
+	assert.Equal(t, time.Date(2026, 5, 14, 0, 0, 0, 0, eastern), days[0].Start)
+}
+
+func load[T any](t *testing.T, name string) []T {
+	t.Helper()
+	f, err := os.Open(filepath.Join(examplesDir, name))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+	var rows []T
+	require.NoError(t, api.ParseCSV(f, &rows, time.UTC))
+	return rows
+}
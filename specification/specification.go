@@ -58,9 +58,20 @@ type File struct {
 }
 
 type CSVColumn struct {
-	Name   string `json:"name"`
-	Type   string `json:"type"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// Format is the time layout for "time" columns.
+	// Alternative layouts are separated by "|".
+	// For "percent" columns it is the suffix Alpha Vantage writes after the
+	// number, "%" or empty.
 	Format string `json:"format,omitempty"`
+	// Field overrides the generated Go struct field name.
+	Field string `json:"field,omitempty"`
+	// Decimals is the minimum number of decimals Alpha Vantage writes for
+	// numeric columns.
+	Decimals int `json:"decimals,omitempty"`
+	// Null is the value Alpha Vantage writes for missing values.
+	Null string `json:"null,omitempty"`
 }
 
 type Function struct {
@@ -0,0 +1,127 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/portfoliotree/alphavantage/api"
+)
+
+// timestampLayout sorts lexically in time order.
+const timestampLayout = "2006-01-02 15:04:05.000000000"
+
+// codec writes rows with api.Encoder so stored files read like Alpha Vantage
+// responses. Timestamps are written in location, the time zone AlphaVantage
+// writes them in, as the files have no zone offsets.
+type codec[T any] struct {
+	timeField int
+	location  *time.Location
+	// buf and encoder format single rows for record.
+	buf     bytes.Buffer
+	encoder *api.Encoder[T]
+}
+
+func newCodec[T any](location *time.Location) (*codec[T], error) {
+	rowType := reflect.TypeFor[T]()
+	if rowType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("row type %s is not a struct", rowType)
+	}
+	c := &codec[T]{timeField: -1, location: location}
+	for i := range rowType.NumField() {
+		field := rowType.Field(i)
+		if field.Tag.Get("column-name") != "timestamp" {
+			continue
+		}
+		if field.Type.Kind() == reflect.String || field.Type == reflect.TypeFor[time.Time]() {
+			c.timeField = i
+		}
+	}
+	if c.timeField < 0 {
+		return nil, fmt.Errorf("row type %s has no timestamp column", rowType)
+	}
+	c.encoder = api.NewEncoder[T](&c.buf)
+	return c, nil
+}
+
+// timestamp returns a key for row that sorts in time order.
+func (c *codec[T]) timestamp(row T) string {
+	v := reflect.ValueOf(row).Field(c.timeField)
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.In(c.location).Format(timestampLayout)
+	}
+	return v.String()
+}
+
+// local returns row with its timestamp in c.location, so rows parsed in
+// another location, for example with Client.Location set, are stored at
+// the same instant.
+func (c *codec[T]) local(row T) T {
+	v := reflect.ValueOf(&row).Elem().Field(c.timeField)
+	if t, ok := v.Interface().(time.Time); ok && !t.IsZero() {
+		v.Set(reflect.ValueOf(t.In(c.location)))
+	}
+	return row
+}
+
+// record returns row as a CSV line.
+func (c *codec[T]) record(row T) (string, error) {
+	c.buf.Reset()
+	if err := c.encoder.Encode(c.local(row)); err != nil {
+		return "", err
+	}
+	if err := c.encoder.Flush(); err != nil {
+		return "", err
+	}
+	return c.buf.String(), nil
+}
+
+func (c *codec[T]) appendFile(path string, rows []T) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return err
+	}
+	if err := c.encodeRows(api.NewEncoder[T](f), rows); err != nil {
+		closeAndIgnoreError(f)
+		return err
+	}
+	return f.Close()
+}
+
+// writeFile replaces the file at path with a temporary file so readers never
+// see a partially written series.
+func (c *codec[T]) writeFile(path string, rows []T) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	e := api.NewEncoder[T](f)
+	err = e.WriteHeader()
+	if err == nil {
+		err = c.encodeRows(e, rows)
+	}
+	if err != nil {
+		closeAndIgnoreError(f)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func (c *codec[T]) encodeRows(e *api.Encoder[T], rows []T) error {
+	for _, row := range rows {
+		if err := e.Encode(c.local(row)); err != nil {
+			return err
+		}
+	}
+	return e.Flush()
+}
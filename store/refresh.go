@@ -0,0 +1,252 @@
+package store
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/portfoliotree/alphavantage"
+	"github.com/portfoliotree/alphavantage/query/commodities"
+	"github.com/portfoliotree/alphavantage/query/crypto"
+	"github.com/portfoliotree/alphavantage/query/economic"
+	"github.com/portfoliotree/alphavantage/query/forex"
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+)
+
+// CompactSize is the number of rows AlphaVantage returns with
+// outputsize=compact.
+const CompactSize = 100
+
+// Fetcher requests a series. When compact is true it should request only the
+// most recent CompactSize rows (OutputSizeCompact); otherwise the full
+// history (OutputSizeFull). Functions without an outputsize parameter may
+// ignore compact.
+type Fetcher[T any] func(ctx context.Context, compact bool) ([]T, error)
+
+// Refresh brings the stored series up to date and merges the fetched rows.
+// It requests the compact output when the rows published since the last
+// stored row fit in the compact window, estimated from key.Interval, and the
+// full output otherwise. When a compact response does not reach back to the
+// stored rows the full output is requested as well.
+func Refresh[T any](ctx context.Context, s *Store, key Key, fetch Fetcher[T]) (MergeResult[T], error) {
+	location, err := key.location()
+	if err != nil {
+		return MergeResult[T]{}, err
+	}
+	c, err := newCodec[T](location)
+	if err != nil {
+		return MergeResult[T]{}, err
+	}
+	stored, err := Load[T](s, key)
+	if err != nil {
+		return MergeResult[T]{}, err
+	}
+	compact := false
+	var last string
+	if len(stored) > 0 {
+		last = c.timestamp(stored[len(stored)-1])
+		compact = true
+		for _, layout := range []string{timestampLayout, time.DateTime, time.DateOnly} {
+			if t, err := time.ParseInLocation(layout, last, location); err == nil {
+				compact = expectedRows(key.Interval, t, time.Now()) < CompactSize
+				break
+			}
+		}
+	}
+	rows, err := fetch(ctx, compact)
+	if err != nil {
+		return MergeResult[T]{}, fmt.Errorf("failed to refresh %s: %w", key, err)
+	}
+	if compact && !reachesBack(c, rows, last) {
+		rows, err = fetch(ctx, false)
+		if err != nil {
+			return MergeResult[T]{}, fmt.Errorf("failed to refresh %s: %w", key, err)
+		}
+	}
+	return Merge(s, key, rows)
+}
+
+// reachesBack reports whether rows overlap the stored rows ending at last.
+func reachesBack[T any](c *codec[T], rows []T, last string) bool {
+	for _, row := range rows {
+		if c.timestamp(row) <= last {
+			return true
+		}
+	}
+	return false
+}
+
+// expectedRows estimates how many rows a series with interval publishes
+// between since and now, counting only weekdays for daily and intraday
+// series. Unknown intervals are treated as daily.
+func expectedRows(interval string, since, now time.Time) int {
+	days := now.Sub(since).Hours() / 24
+	switch interval {
+	case "weekly":
+		return int(days/7) + 1
+	case "monthly":
+		return int(days/30) + 1
+	case "quarterly":
+		return int(days/91) + 1
+	case "semiannual":
+		return int(days/182) + 1
+	case "annual":
+		return int(days/365) + 1
+	}
+	weekdays := 0
+	for d := since; d.Before(now); d = d.AddDate(0, 0, 1) {
+		if d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
+			weekdays++
+		}
+	}
+	if minutes, err := strconv.Atoi(strings.TrimSuffix(interval, "min")); err == nil && minutes > 0 && strings.HasSuffix(interval, "min") {
+		// extended hours run from 4:00 to 20:00
+		return weekdays * 16 * 60 / minutes
+	}
+	return weekdays
+}
+
+// RefreshDaily refreshes TIME_SERIES_DAILY for symbol.
+func RefreshDaily(ctx context.Context, s *Store, client *alphavantage.Client, symbol string) (MergeResult[timeseries.DailyRow], error) {
+	key := Key{Function: "TIME_SERIES_DAILY", Symbol: symbol}
+	return Refresh(ctx, s, key, func(ctx context.Context, compact bool) ([]timeseries.DailyRow, error) {
+		q := timeseries.QueryDaily(client.APIKey, symbol).DataTypeCSV()
+		if compact {
+			return client.TimeSeries().Daily(ctx, q.OutputSizeCompact())
+		}
+		return client.TimeSeries().Daily(ctx, q.OutputSizeFull())
+	})
+}
+
+// RefreshIntraday refreshes TIME_SERIES_INTRADAY for symbol and interval.
+// The full output only covers the most recent month; use Backfill on the
+// client for older months.
+func RefreshIntraday(ctx context.Context, s *Store, client *alphavantage.Client, symbol, interval string) (MergeResult[timeseries.IntradayRow], error) {
+	key := Key{Function: "TIME_SERIES_INTRADAY", Symbol: symbol, Interval: interval}
+	return Refresh(ctx, s, key, func(ctx context.Context, compact bool) ([]timeseries.IntradayRow, error) {
+		q := timeseries.QueryIntraday(client.APIKey, symbol, interval).DataTypeCSV()
+		if compact {
+			return client.TimeSeries().Intraday(ctx, q.OutputSizeCompact())
+		}
+		return client.TimeSeries().Intraday(ctx, q.OutputSizeFull())
+	})
+}
+
+// RefreshFXDaily refreshes FX_DAILY for the currency pair.
+func RefreshFXDaily(ctx context.Context, s *Store, client *alphavantage.Client, fromSymbol, toSymbol string) (MergeResult[forex.DailyRow], error) {
+	key := Key{Function: "FX_DAILY", Symbol: fromSymbol + toSymbol}
+	return Refresh(ctx, s, key, func(ctx context.Context, compact bool) ([]forex.DailyRow, error) {
+		q := forex.QueryDaily(client.APIKey, fromSymbol, toSymbol).DataTypeCSV()
+		if compact {
+			return client.Forex().Daily(ctx, q.OutputSizeCompact())
+		}
+		return client.Forex().Daily(ctx, q.OutputSizeFull())
+	})
+}
+
+// RefreshCryptoDaily refreshes DIGITAL_CURRENCY_DAILY for symbol priced in
+// market. The response always has the full history.
+func RefreshCryptoDaily(ctx context.Context, s *Store, client *alphavantage.Client, symbol, market string) (MergeResult[crypto.DigitalCurrencyDailyRow], error) {
+	key := Key{Function: "DIGITAL_CURRENCY_DAILY", Symbol: symbol + market}
+	return Refresh(ctx, s, key, func(ctx context.Context, _ bool) ([]crypto.DigitalCurrencyDailyRow, error) {
+		return client.Crypto().DigitalCurrencyDaily(ctx, crypto.QueryDigitalCurrencyDaily(client.APIKey, symbol, market))
+	})
+}
+
+// Observation is the stored row of economic indicators and commodity prices,
+// in the format AlphaVantage sends them. Value is nil for missing values,
+// which are stored as ".".
+type Observation struct {
+	TimeStamp time.Time `column-name:"timestamp" time-layout:"2006-01-02"`
+	Value     *float64  `column-name:"value" null:"."`
+}
+
+func newObservation(date time.Time, value float64, missing bool) Observation {
+	if missing {
+		return Observation{TimeStamp: date}
+	}
+	return Observation{TimeStamp: date, Value: &value}
+}
+
+// RefreshEconomic refreshes an economic indicator with the interval, using
+// EconomicFunctions.Fetch. The empty interval stores the default frequency
+// of the indicator under that frequency, so both name the same series.
+func RefreshEconomic(ctx context.Context, s *Store, client *alphavantage.Client, indicator economic.Indicator, interval string) (MergeResult[Observation], error) {
+	metadata, ok := indicator.Metadata()
+	if !ok {
+		return MergeResult[Observation]{}, fmt.Errorf("unknown economic indicator %q", indicator)
+	}
+	key := Key{Function: string(indicator), Interval: cmp.Or(interval, metadata.Frequency)}
+	return Refresh(ctx, s, key, func(ctx context.Context, _ bool) ([]Observation, error) {
+		observations, err := client.Economic().Fetch(ctx, indicator, interval)
+		if err != nil {
+			return nil, err
+		}
+		rows := make([]Observation, len(observations))
+		for i, o := range observations {
+			rows[i] = newObservation(o.Date, o.Value, o.Missing)
+		}
+		return rows, nil
+	})
+}
+
+// RefreshCommodity refreshes the prices of a commodity with the interval,
+// using CommoditiesFunctions.Fetch. The empty interval stores the default
+// interval of the commodity under that interval, so both name the same
+// series.
+func RefreshCommodity(ctx context.Context, s *Store, client *alphavantage.Client, commodity commodities.Commodity, interval string) (MergeResult[Observation], error) {
+	metadata, ok := commodity.Metadata()
+	if !ok || len(metadata.Intervals) == 0 {
+		return MergeResult[Observation]{}, fmt.Errorf("unknown commodity %q", commodity)
+	}
+	key := Key{Function: string(commodity), Interval: cmp.Or(interval, metadata.Intervals[0])}
+	return Refresh(ctx, s, key, func(ctx context.Context, _ bool) ([]Observation, error) {
+		observations, err := client.Commodities().Fetch(ctx, commodity, interval)
+		if err != nil {
+			return nil, err
+		}
+		rows := make([]Observation, len(observations))
+		for i, o := range observations {
+			rows[i] = newObservation(o.Date, o.Value, o.Missing)
+		}
+		return rows, nil
+	})
+}
+
+// MonthStore adapts s to the alphavantage.MonthStore interface used by
+// TimeSeriesFunctions.Backfill. Months are stored as TIME_SERIES_INTRADAY
+// series with the interval and month in the key, for example
+// TIME_SERIES_INTRADAY/IBM_1min_2024-01.csv.
+func (s *Store) MonthStore() alphavantage.MonthStore { return monthStore{s} }
+
+type monthStore struct{ s *Store }
+
+func (m monthStore) key(symbol, interval string, month time.Time) Key {
+	return Key{Function: "TIME_SERIES_INTRADAY", Symbol: symbol, Interval: interval + "_" + month.Format("2006-01")}
+}
+
+func (m monthStore) LoadMonth(symbol, interval string, month time.Time) ([]timeseries.IntradayRow, bool, error) {
+	path, err := m.s.path(m.key(symbol, interval, month))
+	if err != nil {
+		return nil, false, err
+	}
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	rows, err := Load[timeseries.IntradayRow](m.s, m.key(symbol, interval, month))
+	return rows, err == nil, err
+}
+
+func (m monthStore) SaveMonth(symbol, interval string, month time.Time, rows []timeseries.IntradayRow) error {
+	_, err := Merge(m.s, m.key(symbol, interval, month), rows)
+	return err
+}
@@ -0,0 +1,206 @@
+// Package store keeps AlphaVantage series on local disk so they can be
+// refreshed incrementally instead of downloaded again.
+//
+// Each series is a CSV file with the AlphaVantage column names, one file per
+// function, symbol and interval:
+//
+//	<dir>/TIME_SERIES_DAILY/IBM.csv
+//	<dir>/TIME_SERIES_INTRADAY/IBM_5min.csv
+//	<dir>/FX_DAILY/EURUSD.csv
+//	<dir>/TREASURY_YIELD/monthly.csv
+//
+// Rows are stored from the oldest to the most recent so new rows are
+// appended to the end of the file. Any row type with a "timestamp" column
+// (time.Time or string) can be stored, which covers the time series, forex,
+// crypto, economic and commodity rows. RefreshDaily, RefreshIntraday,
+// RefreshFXDaily, RefreshCryptoDaily, RefreshEconomic and RefreshCommodity
+// keep common series up to date; Refresh works with any query.
+package store
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/portfoliotree/alphavantage/api"
+)
+
+// Store is a directory of series files. It is safe for concurrent use by one
+// process.
+type Store struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// Open returns a Store in dir, creating the directory when it does not
+// exist.
+func Open(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &Store{dir: dir}, nil
+}
+
+// Key identifies a series. Symbol and Interval may be empty for series
+// without them, such as commodities or economic indicators with the default
+// interval.
+type Key struct {
+	Function string
+	Symbol   string
+	Interval string
+}
+
+// location returns the time zone AlphaVantage writes the timestamps of the
+// series in; see api.FunctionLocation.
+func (k Key) location() (*time.Location, error) {
+	location, err := api.FunctionLocation(k.Function, k.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load time zone of %s: %w", k, err)
+	}
+	return location, nil
+}
+
+func (k Key) String() string {
+	return strings.Join(slices.DeleteFunc([]string{k.Function, k.Symbol, k.Interval}, func(s string) bool { return s == "" }), " ")
+}
+
+func (s *Store) path(key Key) (string, error) {
+	name := strings.Join(slices.DeleteFunc([]string{key.Symbol, key.Interval}, func(s string) bool { return s == "" }), "_")
+	name = cmp.Or(name, "series")
+	for _, part := range []string{key.Function, name} {
+		if part == "" || part == "." || part == ".." || strings.ContainsAny(part, `/\`) {
+			return "", fmt.Errorf("invalid store key %q", key)
+		}
+	}
+	return filepath.Join(s.dir, key.Function, name+".csv"), nil
+}
+
+// Load reads the stored rows of the series from the oldest to the most
+// recent. A series that has not been stored has no rows. Timestamps are
+// parsed in the time zone AlphaVantage uses for the function, as the client
+// parses them; see api.FunctionLocation.
+func Load[T any](s *Store, key Key) ([]T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return load[T](s, key)
+}
+
+func load[T any](s *Store, key Key) ([]T, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer closeAndIgnoreError(f)
+	location, err := key.location()
+	if err != nil {
+		return nil, err
+	}
+	var rows []T
+	if err := api.ParseCSV(f, &rows, location); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return rows, nil
+}
+
+// Restatement is a stored row that a later response changed.
+type Restatement[T any] struct {
+	Old, New T
+}
+
+// MergeResult reports the changes Merge made to a series.
+type MergeResult[T any] struct {
+	// Added is the number of rows with a new timestamp.
+	Added int
+	// Restated lists the rows whose values changed, such as revised
+	// economic data or prices adjusted after a correction.
+	Restated []Restatement[T]
+}
+
+// Merge adds rows, in any order, to the stored series. Rows with a timestamp
+// that is already stored replace the stored row and are reported as
+// restatements when any value differs. When every row is newer than the
+// stored rows they are appended; otherwise the file is rewritten.
+func Merge[T any](s *Store, key Key, rows []T) (MergeResult[T], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result MergeResult[T]
+	location, err := key.location()
+	if err != nil {
+		return result, err
+	}
+	c, err := newCodec[T](location)
+	if err != nil {
+		return result, err
+	}
+	stored, err := load[T](s, key)
+	if err != nil {
+		return result, err
+	}
+	storedIndex := make(map[string]int, len(stored))
+	for i, row := range stored {
+		storedIndex[c.timestamp(row)] = i
+	}
+	rows = slices.Clone(rows)
+	slices.SortStableFunc(rows, func(a, b T) int { return strings.Compare(c.timestamp(a), c.timestamp(b)) })
+
+	var added []T
+	for _, row := range rows {
+		i, ok := storedIndex[c.timestamp(row)]
+		if !ok {
+			storedIndex[c.timestamp(row)] = len(stored) + len(added)
+			added = append(added, row)
+			continue
+		}
+		if i >= len(stored) {
+			// a timestamp repeated within rows; the last one wins
+			added[i-len(stored)] = row
+			continue
+		}
+		old, err := c.record(stored[i])
+		if err != nil {
+			return result, err
+		}
+		updated, err := c.record(row)
+		if err != nil {
+			return result, err
+		}
+		if old != updated {
+			result.Restated = append(result.Restated, Restatement[T]{Old: stored[i], New: row})
+			stored[i] = row
+		}
+	}
+	result.Added = len(added)
+
+	path, err := s.path(key)
+	if err != nil {
+		return result, err
+	}
+	appendOnly := len(result.Restated) == 0 && len(stored) > 0 &&
+		(len(added) == 0 || c.timestamp(added[0]) > c.timestamp(stored[len(stored)-1]))
+	if appendOnly {
+		if len(added) == 0 {
+			return result, nil
+		}
+		return result, c.appendFile(path, added)
+	}
+	all := append(stored, added...)
+	slices.SortStableFunc(all, func(a, b T) int { return strings.Compare(c.timestamp(a), c.timestamp(b)) })
+	return result, c.writeFile(path, all)
+}
+
+func closeAndIgnoreError(f *os.File) { _ = f.Close() }
@@ -0,0 +1,320 @@
+package store_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/portfoliotree/alphavantage"
+	"github.com/portfoliotree/alphavantage/api"
+	"github.com/portfoliotree/alphavantage/query/commodities"
+	"github.com/portfoliotree/alphavantage/query/crypto"
+	"github.com/portfoliotree/alphavantage/query/economic"
+	"github.com/portfoliotree/alphavantage/query/timeseries"
+	"github.com/portfoliotree/alphavantage/store"
+)
+
+func TestMerge(t *testing.T) {
+	dir := t.TempDir()
+	s, err := store.Open(dir)
+	require.NoError(t, err)
+	key := store.Key{Function: "TIME_SERIES_DAILY", Symbol: "IBM"}
+	day := func(d int) time.Time { return time.Date(2024, 6, d, 0, 0, 0, 0, time.UTC) }
+
+	rows, err := store.Load[timeseries.DailyRow](s, key)
+	require.NoError(t, err)
+	assert.Empty(t, rows)
+
+	// responses are newest first
+	result, err := store.Merge(s, key, []timeseries.DailyRow{
+		{TimeStamp: day(4), Open: 2, High: 2.5, Low: 1.5, Close: 2.25, Volume: 200},
+		{TimeStamp: day(3), Open: 1, High: 1.5, Low: 0.5, Close: 1.25, Volume: 100},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Added)
+	assert.Empty(t, result.Restated)
+
+	result, err = store.Merge(s, key, []timeseries.DailyRow{
+		{TimeStamp: day(5), Open: 3, High: 3, Low: 3, Close: 3, Volume: 300},
+		{TimeStamp: day(4), Open: 2, High: 2.5, Low: 1.5, Close: 2.25, Volume: 200},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Added)
+	assert.Empty(t, result.Restated)
+	buf, err := os.ReadFile(filepath.Join(dir, "TIME_SERIES_DAILY", "IBM.csv"))
+	require.NoError(t, err)
+	assert.Equal(t, "timestamp,open,high,low,close,volume\n"+
+		"2024-06-03,1.0000,1.5000,0.5000,1.2500,100\n"+
+		"2024-06-04,2.0000,2.5000,1.5000,2.2500,200\n"+
+		"2024-06-05,3.0000,3.0000,3.0000,3.0000,300\n", string(buf), "rows are written in the Alpha Vantage format")
+
+	restated := timeseries.DailyRow{TimeStamp: day(4), Open: 2, High: 2.5, Low: 1.5, Close: 2.3, Volume: 210}
+	result, err = store.Merge(s, key, []timeseries.DailyRow{restated, {TimeStamp: day(1), Close: 0.5}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Added)
+	require.Len(t, result.Restated, 1)
+	assert.Equal(t, 2.25, result.Restated[0].Old.Close)
+	assert.Equal(t, restated, result.Restated[0].New)
+
+	rows, err = store.Load[timeseries.DailyRow](s, key)
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	assert.Equal(t, day(1), rows[0].TimeStamp)
+	assert.Equal(t, restated, rows[2])
+	assert.Equal(t, day(5), rows[3].TimeStamp)
+
+	_, err = store.Merge(s, store.Key{Function: "../x"}, rows)
+	assert.Error(t, err)
+}
+
+func TestMerge_economic(t *testing.T) {
+	s, err := store.Open(t.TempDir())
+	require.NoError(t, err)
+	key := store.Key{Function: "FEDERAL_FUNDS_RATE", Interval: "monthly"}
+	_, err = store.Merge(s, key, []economic.FederalFundsRateRow{{TimeStamp: "2024-02-01", Value: "."}, {TimeStamp: "2024-01-01", Value: "4.06"}})
+	require.NoError(t, err)
+	result, err := store.Merge(s, key, []economic.FederalFundsRateRow{{TimeStamp: "2024-02-01", Value: "4.21"}})
+	require.NoError(t, err)
+	require.Len(t, result.Restated, 1)
+	assert.Equal(t, ".", result.Restated[0].Old.Value)
+
+	rows, err := store.Load[economic.FederalFundsRateRow](s, key)
+	require.NoError(t, err)
+	assert.Equal(t, []economic.FederalFundsRateRow{{TimeStamp: "2024-01-01", Value: "4.06"}, {TimeStamp: "2024-02-01", Value: "4.21"}}, rows)
+}
+
+func TestRefresh(t *testing.T) {
+	s, err := store.Open(t.TempDir())
+	require.NoError(t, err)
+	key := store.Key{Function: "TIME_SERIES_DAILY", Symbol: "IBM"}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	bars := func(days ...int) []timeseries.DailyRow {
+		var rows []timeseries.DailyRow
+		for _, d := range days {
+			rows = append(rows, timeseries.DailyRow{TimeStamp: today.AddDate(0, 0, -d), Close: float64(d)})
+		}
+		return rows
+	}
+
+	var requests []bool
+	fetch := func(response []timeseries.DailyRow) store.Fetcher[timeseries.DailyRow] {
+		return func(_ context.Context, compact bool) ([]timeseries.DailyRow, error) {
+			requests = append(requests, compact)
+			return response, nil
+		}
+	}
+
+	_, err = store.Refresh(t.Context(), s, key, fetch(bars(400, 10, 5)))
+	require.NoError(t, err)
+	assert.Equal(t, []bool{false}, requests, "an empty store needs the full history")
+
+	requests = nil
+	result, err := store.Refresh(t.Context(), s, key, fetch(bars(5, 1)))
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true}, requests, "five days fit in the compact window")
+	assert.Equal(t, 1, result.Added)
+
+	requests = nil
+	_, err = store.Refresh(t.Context(), s, key, fetch(bars(0)))
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, requests, "a compact response without overlap falls back to the full history")
+
+	old := store.Key{Function: "TIME_SERIES_DAILY", Symbol: "OLD"}
+	_, err = store.Merge(s, old, bars(400))
+	require.NoError(t, err)
+	requests = nil
+	_, err = store.Refresh(t.Context(), s, old, fetch(bars(400, 0)))
+	require.NoError(t, err)
+	assert.Equal(t, []bool{false}, requests, "more than 100 trading days are missing")
+}
+
+func TestRefreshDaily(t *testing.T) {
+	var outputSizes []string
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "TIME_SERIES_DAILY", req.URL.Query().Get("function"))
+		outputSizes = append(outputSizes, req.URL.Query().Get("outputsize"))
+		http.ServeFile(res, req, "../specification/testdata/examples/time_series/TIME_SERIES_DAILY_42a08190.csv")
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+	client := alphavantage.NewClient()
+	client.Limiter = nil
+
+	s, err := store.Open(t.TempDir())
+	require.NoError(t, err)
+	result, err := store.RefreshDaily(t.Context(), s, client, "IBM")
+	require.NoError(t, err)
+	assert.Equal(t, 100, result.Added)
+	assert.Equal(t, []string{"full"}, outputSizes)
+
+	rows, err := store.Load[timeseries.DailyRow](s, store.Key{Function: "TIME_SERIES_DAILY", Symbol: "IBM"})
+	require.NoError(t, err)
+	require.Len(t, rows, 100)
+	assert.Equal(t, time.Date(2025, 12, 22, 0, 0, 0, 0, time.UTC), rows[0].TimeStamp)
+	assert.Equal(t, 219.3, rows[99].Close)
+}
+
+func TestRefreshEconomic(t *testing.T) {
+	var intervals []string
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "CPI", req.URL.Query().Get("function"))
+		intervals = append(intervals, req.URL.Query().Get("interval"))
+		http.ServeFile(res, req, "../specification/testdata/examples/economic/CPI_453fbf7c.csv")
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+	client := alphavantage.NewClient()
+	client.Limiter = nil
+
+	dir := t.TempDir()
+	s, err := store.Open(dir)
+	require.NoError(t, err)
+	result, err := store.RefreshEconomic(t.Context(), s, client, economic.CPI, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1360, result.Added)
+	result, err = store.RefreshEconomic(t.Context(), s, client, economic.CPI, "monthly")
+	require.NoError(t, err)
+	assert.Zero(t, result.Added, "the default frequency is stored under its interval")
+	assert.Empty(t, result.Restated)
+	assert.Equal(t, []string{"", "monthly"}, intervals)
+	assert.FileExists(t, filepath.Join(dir, "CPI", "monthly.csv"))
+
+	rows, err := store.Load[store.Observation](s, store.Key{Function: "CPI", Interval: "monthly"})
+	require.NoError(t, err)
+	require.Len(t, rows, 1360)
+	last := rows[len(rows)-1]
+	assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), last.TimeStamp)
+	require.NotNil(t, last.Value)
+	assert.Equal(t, 333.02, *last.Value)
+	missing := rows[len(rows)-7]
+	assert.Equal(t, time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), missing.TimeStamp)
+	assert.Nil(t, missing.Value, "missing values are kept")
+
+	_, err = store.RefreshEconomic(t.Context(), s, client, "UNKNOWN", "")
+	assert.Error(t, err)
+}
+
+func TestRefreshCommodity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "COPPER", req.URL.Query().Get("function"))
+		http.ServeFile(res, req, "../specification/testdata/examples/commodities/COPPER_639627b4.csv")
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+	client := alphavantage.NewClient()
+	client.Limiter = nil
+
+	s, err := store.Open(t.TempDir())
+	require.NoError(t, err)
+	result, err := store.RefreshCommodity(t.Context(), s, client, commodities.Copper, "")
+	require.NoError(t, err)
+	assert.Equal(t, 555, result.Added)
+
+	rows, err := store.Load[store.Observation](s, store.Key{Function: "COPPER", Interval: "monthly"})
+	require.NoError(t, err)
+	require.Len(t, rows, 555)
+	require.NotNil(t, rows[len(rows)-1].Value)
+	assert.Equal(t, 12528.70954545455, *rows[len(rows)-1].Value)
+
+	_, err = store.RefreshCommodity(t.Context(), s, client, commodities.Copper, "daily")
+	assert.Error(t, err, "copper has no daily prices")
+}
+
+func TestRefreshCryptoDaily(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DIGITAL_CURRENCY_DAILY", req.URL.Query().Get("function"))
+		assert.Equal(t, "EUR", req.URL.Query().Get("market"))
+		http.ServeFile(res, req, "../specification/testdata/examples/crypto/DIGITAL_CURRENCY_DAILY_ffab6e21.csv")
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+	client := alphavantage.NewClient()
+	client.Limiter = nil
+
+	s, err := store.Open(t.TempDir())
+	require.NoError(t, err)
+	result, err := store.RefreshCryptoDaily(t.Context(), s, client, "BTC", "EUR")
+	require.NoError(t, err)
+	assert.Equal(t, 350, result.Added)
+
+	rows, err := store.Load[crypto.DigitalCurrencyDailyRow](s, store.Key{Function: "DIGITAL_CURRENCY_DAILY", Symbol: "BTCEUR"})
+	require.NoError(t, err)
+	require.Len(t, rows, 350)
+	assert.Equal(t, time.Date(2026, 5, 17, 0, 0, 0, 0, time.UTC), rows[349].TimeStamp)
+	assert.Equal(t, 67310.48, rows[349].Close)
+}
+
+func TestStore_MonthStore(t *testing.T) {
+	s, err := store.Open(t.TempDir())
+	require.NoError(t, err)
+	months := s.MonthStore()
+	month := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, ok, err := months.LoadMonth("IBM", "1min", month)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, months.SaveMonth("IBM", "1min", month, nil))
+	rows, ok, err := months.LoadMonth("IBM", "1min", month)
+	require.NoError(t, err)
+	assert.True(t, ok, "a month without bars is still saved")
+	assert.Empty(t, rows)
+
+	eastern, err := api.Eastern()
+	require.NoError(t, err)
+	bar := timeseries.IntradayRow{TimeStamp: time.Date(2024, 1, 2, 9, 30, 0, 0, eastern), Close: 1, Volume: 5}
+	require.NoError(t, months.SaveMonth("IBM", "1min", month, []timeseries.IntradayRow{bar}))
+	rows, ok, err = months.LoadMonth("IBM", "1min", month)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []timeseries.IntradayRow{bar}, rows)
+
+	utc := timeseries.IntradayRow{TimeStamp: time.Date(2024, 1, 2, 14, 31, 0, 0, time.UTC), Close: 2}
+	require.NoError(t, months.SaveMonth("IBM", "1min", month, []timeseries.IntradayRow{utc}))
+	rows, _, err = months.LoadMonth("IBM", "1min", month)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.True(t, utc.TimeStamp.Equal(rows[1].TimeStamp), "rows parsed in another location keep their instant")
+	assert.Equal(t, "09:31", rows[1].TimeStamp.Format("15:04"))
+}
+
+func TestRefreshIntraday(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		http.ServeFile(res, req, "../specification/testdata/examples/time_series/TIME_SERIES_INTRADAY_3dbdcdc7.csv")
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(alphavantage.APIURLEnvironmentVariableName, server.URL)
+	client := alphavantage.NewClient()
+	client.Limiter = nil
+
+	fetched, err := client.TimeSeries().Intraday(t.Context(), timeseries.QueryIntraday(client.APIKey, "IBM", "5min").DataTypeCSV())
+	require.NoError(t, err)
+	require.NotEmpty(t, fetched)
+
+	s, err := store.Open(t.TempDir())
+	require.NoError(t, err)
+	result, err := store.RefreshIntraday(t.Context(), s, client, "IBM", "5min")
+	require.NoError(t, err)
+	assert.Equal(t, len(fetched), result.Added)
+
+	rows, err := store.Load[timeseries.IntradayRow](s, store.Key{Function: "TIME_SERIES_INTRADAY", Symbol: "IBM", Interval: "5min"})
+	require.NoError(t, err)
+	require.Len(t, rows, len(fetched))
+	for i, row := range rows {
+		want := fetched[len(fetched)-1-i] // stored oldest first
+		assert.True(t, want.TimeStamp.Equal(row.TimeStamp), "%s is loaded as %s", want.TimeStamp, row.TimeStamp)
+		assert.Equal(t, want, row)
+	}
+
+	result, err = store.RefreshIntraday(t.Context(), s, client, "IBM", "5min")
+	require.NoError(t, err)
+	assert.Zero(t, result.Added)
+	assert.Empty(t, result.Restated, "reloaded rows match fetched rows")
+}
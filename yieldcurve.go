@@ -0,0 +1,240 @@
+package alphavantage
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/portfoliotree/alphavantage/query/economic"
+)
+
+// TreasuryMaturities lists the maturity parameter values of TREASURY_YIELD
+// from the shortest to the longest.
+var TreasuryMaturities = []string{"3month", "2year", "5year", "7year", "10year", "30year"}
+
+// Interpolation selects how YieldCurve estimates the yield between
+// maturities.
+type Interpolation int
+
+const (
+	// LinearInterpolation joins neighbouring maturities with straight lines.
+	LinearInterpolation Interpolation = iota
+	// CubicInterpolation fits a natural cubic spline through the maturities.
+	CubicInterpolation
+)
+
+// YieldCurve holds TREASURY_YIELD yields, in percent, aligned by date. The
+// panel columns are maturities such as "2year" ordered from the shortest to
+// the longest. Missing observations, which AlphaVantage sends as ".", are
+// NaN.
+type YieldCurve struct {
+	Panel
+	// Tenors holds the maturity of each column in years.
+	Tenors []float64
+}
+
+// NewYieldCurve aligns the TREASURY_YIELD series of maturities, given in the
+// same order, on the union of their dates.
+func NewYieldCurve(maturities []string, series [][]economic.TreasuryYieldRow) (YieldCurve, error) {
+	tenors := make([]float64, len(maturities))
+	for i, maturity := range maturities {
+		years, err := maturityYears(maturity)
+		if err != nil {
+			return YieldCurve{}, err
+		}
+		tenors[i] = years
+	}
+	panel := NewPanel(Union, maturities, series,
+		func(row economic.TreasuryYieldRow) time.Time { return row.TimeStamp },
+		func(row economic.TreasuryYieldRow) float64 { return row.Value },
+	)
+	order := make([]int, len(tenors))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return cmp.Compare(tenors[a], tenors[b]) })
+	curve := YieldCurve{Panel: Panel{Dates: panel.Dates}}
+	for _, i := range order {
+		curve.Symbols = append(curve.Symbols, panel.Symbols[i])
+		curve.Columns = append(curve.Columns, panel.Columns[i])
+		curve.Tenors = append(curve.Tenors, tenors[i])
+	}
+	return curve, nil
+}
+
+// maturityYears converts a maturity such as "3month" or "10year" to years.
+func maturityYears(maturity string) (float64, error) {
+	for _, unit := range []struct {
+		suffix string
+		years  float64
+	}{
+		{suffix: "month", years: 1.0 / 12},
+		{suffix: "year", years: 1},
+	} {
+		if n, ok := strings.CutSuffix(maturity, unit.suffix); ok {
+			v, err := strconv.Atoi(n)
+			if err != nil || v <= 0 {
+				break
+			}
+			return float64(v) * unit.years, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown treasury maturity %q", maturity)
+}
+
+// YieldCurve fetches TREASURY_YIELD for each of TreasuryMaturities with
+// interval (daily, weekly or monthly) concurrently, waiting on the client
+// Limiter before each request, and aligns them by date.
+func (f *EconomicFunctions) YieldCurve(ctx context.Context, interval string) (YieldCurve, error) {
+	series, err := fetchEach(ctx, TreasuryMaturities, func(ctx context.Context, maturity string) ([]economic.TreasuryYieldRow, error) {
+		rows, err := f.TreasuryYield(ctx, economic.QueryTreasuryYield(f.APIKey).Interval(interval).Maturity(maturity).DataTypeCSV())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s treasury yield: %w", maturity, err)
+		}
+		return rows, nil
+	})
+	if err != nil {
+		return YieldCurve{}, err
+	}
+	return NewYieldCurve(TreasuryMaturities, series)
+}
+
+// Yield interpolates the yield for a tenor in years on date. It is NaN when
+// the curve has no observations on date or the tenor is outside the
+// maturities observed on date.
+func (c YieldCurve) Yield(date time.Time, years float64, method Interpolation) float64 {
+	i, ok := slices.BinarySearchFunc(c.Dates, date, time.Time.Compare)
+	if !ok {
+		return math.NaN()
+	}
+	return c.interpolate(i, years, method)
+}
+
+// Yields interpolates the yield for a tenor in years on each date.
+func (c YieldCurve) Yields(years float64, method Interpolation) []float64 {
+	values := make([]float64, len(c.Dates))
+	for i := range c.Dates {
+		values[i] = c.interpolate(i, years, method)
+	}
+	return values
+}
+
+func (c YieldCurve) interpolate(i int, years float64, method Interpolation) float64 {
+	xs := make([]float64, 0, len(c.Tenors))
+	ys := make([]float64, 0, len(c.Tenors))
+	for j, column := range c.Columns {
+		if !math.IsNaN(column[i]) {
+			xs = append(xs, c.Tenors[j])
+			ys = append(ys, column[i])
+		}
+	}
+	if len(xs) == 0 || years < xs[0] || years > xs[len(xs)-1] {
+		return math.NaN()
+	}
+	if method == CubicInterpolation && len(xs) > 2 {
+		return naturalCubicSpline(xs, ys, years)
+	}
+	k := segment(xs, years)
+	if k == len(xs)-1 {
+		return ys[k]
+	}
+	t := (years - xs[k]) / (xs[k+1] - xs[k])
+	return ys[k] + t*(ys[k+1]-ys[k])
+}
+
+// segment returns the index k of the last knot with xs[k] <= x.
+func segment(xs []float64, x float64) int {
+	k, found := slices.BinarySearch(xs, x)
+	if !found {
+		k--
+	}
+	return k
+}
+
+// naturalCubicSpline evaluates at x the cubic spline through the knots with
+// zero second derivatives at both ends.
+func naturalCubicSpline(xs, ys []float64, x float64) float64 {
+	n := len(xs)
+	h := make([]float64, n-1)
+	for i := range h {
+		h[i] = xs[i+1] - xs[i]
+	}
+	// solve the tridiagonal system for the second derivatives m[1..n-2]
+	m := make([]float64, n)
+	diag := make([]float64, n)
+	rhs := make([]float64, n)
+	for i := 1; i < n-1; i++ {
+		diag[i] = 2 * (h[i-1] + h[i])
+		rhs[i] = 6 * ((ys[i+1]-ys[i])/h[i] - (ys[i]-ys[i-1])/h[i-1])
+		if i > 1 {
+			w := h[i-1] / diag[i-1]
+			diag[i] -= w * h[i-1]
+			rhs[i] -= w * rhs[i-1]
+		}
+	}
+	for i := n - 2; i >= 1; i-- {
+		m[i] = (rhs[i] - h[i]*m[i+1]) / diag[i]
+	}
+
+	k := min(segment(xs, x), n-2)
+	a, b := xs[k+1]-x, x-xs[k]
+	return m[k]*a*a*a/(6*h[k]) + m[k+1]*b*b*b/(6*h[k]) +
+		(ys[k]/h[k]-m[k]*h[k]/6)*a + (ys[k+1]/h[k]-m[k+1]*h[k]/6)*b
+}
+
+// Spread returns the yield of the long maturity minus the yield of the short
+// maturity on each date, in percentage points. It is nil when the curve does
+// not have either maturity.
+func (c YieldCurve) Spread(short, long string) []float64 {
+	s, l := c.Column(short), c.Column(long)
+	if s == nil || l == nil {
+		return nil
+	}
+	spread := make([]float64, len(c.Dates))
+	for i := range spread {
+		spread[i] = l[i] - s[i]
+	}
+	return spread
+}
+
+// Spread2s10s returns the 10 year minus 2 year spread.
+func (c YieldCurve) Spread2s10s() []float64 { return c.Spread("2year", "10year") }
+
+// Spread3m10y returns the 10 year minus 3 month spread.
+func (c YieldCurve) Spread3m10y() []float64 { return c.Spread("3month", "10year") }
+
+// Inversion is a period when a short maturity yielded more than a long one.
+type Inversion struct {
+	// Start and End are the first and last dates with a negative spread.
+	Start, End time.Time
+	// Trough is the most negative spread in the period.
+	Trough float64
+}
+
+// Inversions returns the periods when the spread between the short and the
+// long maturity was negative. Dates without both yields do not end a period.
+func (c YieldCurve) Inversions(short, long string) []Inversion {
+	var inversions []Inversion
+	var current *Inversion
+	for i, spread := range c.Spread(short, long) {
+		switch {
+		case math.IsNaN(spread):
+			continue
+		case spread < 0:
+			if current == nil {
+				inversions = append(inversions, Inversion{Start: c.Dates[i], Trough: spread})
+				current = &inversions[len(inversions)-1]
+			}
+			current.End = c.Dates[i]
+			current.Trough = min(current.Trough, spread)
+		default:
+			current = nil
+		}
+	}
+	return inversions
+}